@@ -13,8 +13,10 @@ import (
 )
 
 type ServiceInfo struct {
-	Name    string
-	Methods []MethodInfo
+	Name        string
+	PackageName string
+	ImportPath  string
+	Methods     []MethodInfo
 }
 
 type MethodInfo struct {
@@ -30,6 +32,8 @@ type Endpoint struct {
 	Path   string
 }
 
+const protosImportPath = "github.com/mlflow/mlflow-go-backend/pkg/protos"
+
 var routeParameterRegex = regexp.MustCompile(`<[^>]+:([^>]+)>`)
 
 // Get the safe path to use in Fiber registration.
@@ -56,11 +60,12 @@ func GetServiceInfos() ([]ServiceInfo, error) {
 	services := []struct {
 		Name        string
 		PackageName string
+		ImportPath  string
 		Descriptor  protoreflect.FileDescriptor
 	}{
-		{"MlflowService", "protos", protos.File_service_proto},
-		{"ModelRegistryService", "protos", protos.File_model_registry_proto},
-		{"MlflowArtifactsService", "artifacts", artifacts.File_mlflow_artifacts_proto},
+		{"MlflowService", "protos", protosImportPath, protos.File_service_proto},
+		{"ModelRegistryService", "protos", protosImportPath, protos.File_model_registry_proto},
+		{"MlflowArtifactsService", "artifacts", protosImportPath + "/artifacts", artifacts.File_mlflow_artifacts_proto},
 	}
 
 	for _, service := range services {
@@ -71,7 +76,12 @@ func GetServiceInfos() ([]ServiceInfo, error) {
 			return nil, fmt.Errorf("service %s not found", service.Name)
 		}
 
-		serviceInfo := ServiceInfo{Name: service.Name, Methods: make([]MethodInfo, 0)}
+		serviceInfo := ServiceInfo{
+			Name:        service.Name,
+			PackageName: service.PackageName,
+			ImportPath:  service.ImportPath,
+			Methods:     make([]MethodInfo, 0),
+		}
 
 		methods := serviceDescriptor.Methods()
 		for mIdx := range methods.Len() {
@@ -31,9 +31,9 @@ var ServiceInfoMap = map[string]ServiceGenerationInfo{
 			"deleteTraceTag",
 			"deleteTag",
 			"searchRuns",
-			// "listArtifacts",
+			"listArtifacts",
 			"getMetricHistory",
-			// "getMetricHistoryBulkInterval",
+			"getMetricHistoryBulkInterval",
 			"logBatch",
 			// "logModel",
 			"logInputs",
@@ -42,8 +42,18 @@ var ServiceInfoMap = map[string]ServiceGenerationInfo{
 			"endTrace",
 			"getTraceInfo",
 			"getTraceInfoV3",
-			// "searchTraces",
+			"searchTraces",
+			"searchTracesV3",
+			"searchUnifiedTraceHandler",
 			"deleteTraces",
+			"createLoggedModel",
+			"finalizeLoggedModel",
+			"getLoggedModel",
+			"deleteLoggedModel",
+			"setLoggedModelTags",
+			"deleteLoggedModelTag",
+			"LogLoggedModelParams",
+			"searchLoggedModels",
 		},
 	},
 	"ModelRegistryService": {
@@ -55,14 +65,14 @@ var ServiceInfoMap = map[string]ServiceGenerationInfo{
 			"updateRegisteredModel",
 			"deleteRegisteredModel",
 			"getRegisteredModel",
-			// "searchRegisteredModels",
+			"searchRegisteredModels",
 			"getLatestVersions",
-			// "createModelVersion",
+			"createModelVersion",
 			"updateModelVersion",
 			"transitionModelVersionStage",
 			"deleteModelVersion",
 			"getModelVersion",
-			// "searchModelVersions",
+			"searchModelVersions",
 			"getModelVersionDownloadUri",
 			"setRegisteredModelTag",
 			"setModelVersionTag",
@@ -76,10 +86,10 @@ var ServiceInfoMap = map[string]ServiceGenerationInfo{
 	"MlflowArtifactsService": {
 		FileNameWithoutExtension: "artifacts",
 		ServiceName:              "ArtifactsService",
-		ImplementedEndpoints:     []string{
+		ImplementedEndpoints: []string{
 			// "downloadArtifact",
 			// "uploadArtifact",
-			// "listArtifacts",
+			"listArtifacts",
 			// "deleteArtifact",
 			// "createMultipartUpload",
 			// "completeMultipartUpload",
@@ -35,6 +35,9 @@ var validations = map[string]string{
 	"SetExperimentTag_Key":               "required,max=250,validMetricParamOrTagName",
 	"SetExperimentTag_Value":             "max=5000",
 	"SearchExperiments_MaxResults":       "positiveNonZeroInteger,max=50000",
+	"SearchTraces_MaxResults":            "omitempty,gt=0,max=500",
+	"SearchTracesV3_MaxResults":          "omitempty,gt=0,max=500",
+	"SearchUnifiedTraces_MaxResults":     "omitempty,gt=0,max=500",
 	"SetTag_Key":                         "required,max=1000,validMetricParamOrTagName,pathIsUnique",
 	"SetTag_Value":                       "omitempty,truncate=8000",
 	"LogInputs_RunId":                    "required,runId",
@@ -48,6 +51,24 @@ var validations = map[string]string{
 	"Dataset_Schema":                     "max:1048575",
 	"InputTag_Key":                       "required,max=255",
 	"InputTag_Value":                     "required,max=500",
+	"CreateLoggedModel_ExperimentId":     "required,stringAsPositiveInteger",
+	"CreateLoggedModel_Params":           "omitempty,dive",
+	"CreateLoggedModel_Tags":             "omitempty,dive",
+	"FinalizeLoggedModel_Status":         "required",
+	"SetLoggedModelTags_Tags":            "omitempty,dive",
+	"LogLoggedModelParamsRequest_Params": "omitempty,dive",
+	"LoggedModelTag_Key":                 "required,max=250,validMetricParamOrTagName",
+	"LoggedModelTag_Value":               "omitempty,max=8000",
+	"LoggedModelParameter_Key":           "required,max=250,validMetricParamOrTagName",
+	"LoggedModelParameter_Value":         "omitempty,max=6000",
+	"SearchLoggedModels_MaxResults":      "omitempty,gt=0",
+	"CreateMultipartUpload_Path":         "required",
+	"CreateMultipartUpload_NumParts":     "required,gt=0,max=10000",
+	"CompleteMultipartUpload_Path":       "required",
+	"CompleteMultipartUpload_UploadId":   "required",
+	"CompleteMultipartUpload_Parts":      "required",
+	"AbortMultipartUpload_Path":          "required",
+	"AbortMultipartUpload_UploadId":      "required",
 	"RenameRegisteredModel_Name":         "notEmpty,required",
 	"RenameRegisteredModel_NewName":      "notEmpty,required",
 	"SetRegisteredModelTag_Name":         "required",
@@ -74,4 +95,9 @@ var validations = map[string]string{
 	"SetModelVersionTag_Version":         "stringAsInteger",
 	"GetModelVersion_Version":            "stringAsInteger",
 	"GetModelVersionDownloadUri_Version": "stringAsInteger",
+	"CreateModelVersion_Name":            "required",
+	"CreateModelVersion_Source":          "required",
+	"CreateModelVersion_Tags":            "omitempty,dive",
+	"ModelVersionTag_Key":                "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"ModelVersionTag_Value":              "omitempty,max=5000",
 }
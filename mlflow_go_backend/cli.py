@@ -11,6 +11,21 @@ from mlflow.utils import find_free_port
 from mlflow_go_backend.lib import get_lib
 
 
+def _gather_opts(opts, prefix, exclude=()):
+    """Returns the Go options starting with prefix, without it, as a configuration block."""
+    block = {}
+    for key, value in opts.items():
+        if key.startswith(prefix) and key not in exclude:
+            name = key.removeprefix(prefix)
+            if value.lstrip("-").isdigit():
+                block[name] = int(value)
+            elif value.lower() in ("true", "false"):
+                block[name] = value.lower() == "true"
+            else:
+                block[name] = value
+    return block
+
+
 def _get_commands():
     """Returns the MLflow CLI commands with the `server` command replaced with a Go server."""
     commands = mlflow.cli.cli.commands.copy()
@@ -60,22 +75,41 @@ def _get_commands():
                     python_command.append(f"--{key.replace('_', '-')}")
                     python_command.append(str(value))
 
+        # gather the database options, e.g. `database_max_open_conns=20`
+        database = _gather_opts(opts, "database_", exclude={"database_upgrade"})
+        # gather the metric buffer options, e.g. `metric_buffer_window=100ms`
+        metric_buffer = _gather_opts(opts, "metric_buffer_")
+        # gather the run heartbeat options, e.g. `run_heartbeat_timeout=15m`
+        run_heartbeat = _gather_opts(opts, "run_heartbeat_")
+
         # initialize the Go server configuration
         tracking_store_uri = kwargs["backend_store_uri"]
         config = {
+            "admin_token": opts.get("admin_token", ""),
             "address": f'{kwargs["host"]}:{kwargs["port"]}',
+            "artifacts_destination": kwargs["artifacts_destination"],
+            "database": database,
+            "database_upgrade": opts.get("database_upgrade", "false").lower() == "true",
             "default_artifact_root": mlflow.cli.resolve_default_artifact_root(
                 kwargs["serve_artifacts"], kwargs["default_artifact_root"], tracking_store_uri
             ),
+            "idempotency_key_ttl": opts.get("idempotency_key_ttl", "24h"),
             "log_level": opts.get("log_level", "DEBUG" if kwargs["dev"] else "INFO"),
+            "metric_buffer": metric_buffer,
             "python_address": python_address,
             "python_command": python_command,
+            "run_heartbeat": run_heartbeat,
+            "serve_artifacts": kwargs["serve_artifacts"],
             "shutdown_timeout": opts.get("shutdown_timeout", "1m"),
             "static_folder": pathlib.Path(mlflow.server.__file__)
             .parent.joinpath(mlflow.server.REL_STATIC_DIR)
             .resolve()
             .as_posix(),
             "tracking_store_uri": tracking_store_uri,
+            # space separated, as commas separate the Go options
+            "tracking_store_read_replica_uris": opts.get(
+                "tracking_store_read_replica_uris", ""
+            ).split(),
             "model_registry_store_uri": kwargs["registry_store_uri"] or tracking_store_uri,
             "version": mlflow.version.VERSION,
         }
@@ -95,7 +129,10 @@ def _get_commands():
         click.Option(
             ["--go-opts"],
             default=None,
-            help="Additional options forwarded to the Go server",
+            help=(
+                "Additional options forwarded to the Go server, e.g. `database_upgrade=true` to "
+                "upgrade the database schema from revision 0584bdc529eb on"
+            ),
         ),
     )
 
@@ -15,6 +15,8 @@ from mlflow.protos.model_registry_pb2 import (
     GetModelVersionDownloadUri,
     GetRegisteredModel,
     RenameRegisteredModel,
+    SearchModelVersions,
+    SearchRegisteredModels,
     SetModelVersionTag,
     SetRegisteredModelAlias,
     SetRegisteredModelTag,
@@ -22,6 +24,11 @@ from mlflow.protos.model_registry_pb2 import (
     UpdateModelVersion,
     UpdateRegisteredModel,
 )
+from mlflow.store.entities import PagedList
+from mlflow.store.model_registry import (
+    SEARCH_MODEL_VERSION_MAX_RESULTS_DEFAULT,
+    SEARCH_REGISTERED_MODEL_MAX_RESULTS_DEFAULT,
+)
 
 from mlflow_go_backend import is_go_enabled
 from mlflow_go_backend.lib import get_lib
@@ -30,6 +37,27 @@ from mlflow_go_backend.store._service_proxy import _ServiceProxy
 _logger = logging.getLogger(__name__)
 
 
+def _model_version_from_proto(proto):
+    entity = ModelVersion.from_proto(proto)
+    if entity.description == "":
+        entity.description = None
+    return entity
+
+
+def _registered_model_from_proto(proto):
+    entity = RegisteredModel.from_proto(proto)
+    if entity.description == "":
+        entity.description = None
+
+    # during conversion to proto, `version` value became a `string` value.
+    # convert it back to `int` value again to satisfy all the Python tests and related logic.
+    for key in entity.aliases:
+        if entity.aliases[key].isnumeric():
+            entity.aliases[key] = int(entity.aliases[key])
+
+    return entity
+
+
 class _ModelRegistryStore:
     def __init__(self, *args, **kwargs):
         store_uri = args[0] if len(args) > 0 else kwargs.get("db_uri", kwargs.get("root_directory"))
@@ -80,17 +108,29 @@ class _ModelRegistryStore:
             get_lib().ModelRegistryServiceGetRegisteredModel, request
         )
 
-        entity = RegisteredModel.from_proto(response.registered_model)
-        if entity.description == "":
-            entity.description = None
-
-        # during conversion to proto, `version` value became a `string` value.
-        # convert it back to `int` value again to satisfy all the Python tests and related logic.
-        for key in entity.aliases:
-            if entity.aliases[key].isnumeric():
-                entity.aliases[key] = int(entity.aliases[key])
-
-        return entity
+        return _registered_model_from_proto(response.registered_model)
+
+    def search_registered_models(
+        self,
+        filter_string=None,
+        max_results=SEARCH_REGISTERED_MODEL_MAX_RESULTS_DEFAULT,
+        order_by=None,
+        page_token=None,
+    ):
+        request = SearchRegisteredModels(
+            filter=filter_string,
+            max_results=max_results,
+            order_by=order_by,
+            page_token=page_token,
+        )
+        response = self.service.call_endpoint(
+            get_lib().ModelRegistryServiceSearchRegisteredModels, request
+        )
+        registered_models = [
+            _registered_model_from_proto(proto_registered_model)
+            for proto_registered_model in response.registered_models
+        ]
+        return PagedList(registered_models, (response.next_page_token or None))
 
     def delete_model_version(self, name, version):
         request = DeleteModelVersion(name=name, version=str(version))
@@ -101,10 +141,29 @@ class _ModelRegistryStore:
         response = self.service.call_endpoint(
             get_lib().ModelRegistryServiceGetModelVersion, request
         )
-        entity = ModelVersion.from_proto(response.model_version)
-        if entity.description == "":
-            entity.description = None
-        return entity
+        return _model_version_from_proto(response.model_version)
+
+    def search_model_versions(
+        self,
+        filter_string=None,
+        max_results=SEARCH_MODEL_VERSION_MAX_RESULTS_DEFAULT,
+        order_by=None,
+        page_token=None,
+    ):
+        request = SearchModelVersions(
+            filter=filter_string,
+            max_results=max_results,
+            order_by=order_by,
+            page_token=page_token,
+        )
+        response = self.service.call_endpoint(
+            get_lib().ModelRegistryServiceSearchModelVersions, request
+        )
+        model_versions = [
+            _model_version_from_proto(proto_model_version)
+            for proto_model_version in response.model_versions
+        ]
+        return PagedList(model_versions, (response.next_page_token or None))
 
     def update_model_version(self, name, version, description=None):
         request = UpdateModelVersion(name=name, version=str(version), description=description)
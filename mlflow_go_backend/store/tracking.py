@@ -4,6 +4,7 @@ from typing import Dict, Optional
 
 from mlflow.entities import (
     Experiment,
+    LoggedModel,
     Metric,
     Run,
     RunInfo,
@@ -16,25 +17,33 @@ from mlflow.exceptions import MlflowException
 from mlflow.protos import databricks_pb2
 from mlflow.protos.service_pb2 import (
     CreateExperiment,
+    CreateLoggedModel,
     CreateRun,
     DeleteExperiment,
+    DeleteLoggedModel,
+    DeleteLoggedModelTag,
     DeleteRun,
     DeleteTag,
     DeleteTraces,
     DeleteTraceTag,
     EndTrace,
+    FinalizeLoggedModel,
     GetExperiment,
     GetExperimentByName,
+    GetLoggedModel,
     GetMetricHistory,
     GetRun,
     GetTraceInfoV3,
     LogBatch,
+    LogLoggedModelParamsRequest,
     LogMetric,
     LogParam,
     RestoreExperiment,
     RestoreRun,
     SearchExperiments,
+    SearchLoggedModels,
     SearchRuns,
+    SetLoggedModelTags,
     SetTag,
     SetTraceTag,
     StartTraceV3,
@@ -305,6 +314,95 @@ class _TrackingStore:
             (response.next_page_token or None),
         )
 
+    def create_logged_model(
+        self,
+        experiment_id,
+        name=None,
+        source_run_id=None,
+        tags=None,
+        params=None,
+        model_type=None,
+    ):
+        request = CreateLoggedModel(
+            experiment_id=str(experiment_id),
+            name=name,
+            model_type=model_type,
+            source_run_id=source_run_id,
+            params=[param.to_proto() for param in params] if params else [],
+            tags=[tag.to_proto() for tag in tags] if tags else [],
+        )
+        response = self.service.call_endpoint(get_lib().TrackingServiceCreateLoggedModel, request)
+        return LoggedModel.from_proto(response.model)
+
+    def finalize_logged_model(self, model_id, status):
+        request = FinalizeLoggedModel(model_id=model_id, status=status.to_proto())
+        response = self.service.call_endpoint(get_lib().TrackingServiceFinalizeLoggedModel, request)
+        return LoggedModel.from_proto(response.model)
+
+    def get_logged_model(self, model_id):
+        request = GetLoggedModel(model_id=model_id)
+        response = self.service.call_endpoint(get_lib().TrackingServiceGetLoggedModel, request)
+        return LoggedModel.from_proto(response.model)
+
+    def delete_logged_model(self, model_id):
+        request = DeleteLoggedModel(model_id=model_id)
+        self.service.call_endpoint(get_lib().TrackingServiceDeleteLoggedModel, request)
+
+    def set_logged_model_tags(self, model_id, tags):
+        request = SetLoggedModelTags(model_id=model_id, tags=[tag.to_proto() for tag in tags])
+        self.service.call_endpoint(get_lib().TrackingServiceSetLoggedModelTags, request)
+
+    def delete_logged_model_tag(self, model_id, key):
+        request = DeleteLoggedModelTag(model_id=model_id, tag_key=key)
+        self.service.call_endpoint(get_lib().TrackingServiceDeleteLoggedModelTag, request)
+
+    def log_logged_model_params(self, model_id, params):
+        request = LogLoggedModelParamsRequest(
+            model_id=model_id, params=[param.to_proto() for param in params]
+        )
+        self.service.call_endpoint(get_lib().TrackingServiceLogLoggedModelParams, request)
+
+    def search_logged_models(
+        self,
+        experiment_ids,
+        filter_string=None,
+        datasets=None,
+        max_results=None,
+        order_by=None,
+        page_token=None,
+    ):
+        request = SearchLoggedModels(
+            experiment_ids=[str(experiment_id) for experiment_id in experiment_ids],
+            filter=filter_string,
+            datasets=[
+                SearchLoggedModels.Dataset(
+                    dataset_name=dataset["dataset_name"],
+                    dataset_digest=dataset.get("dataset_digest"),
+                )
+                for dataset in datasets
+            ]
+            if datasets
+            else [],
+            max_results=max_results,
+            order_by=[
+                SearchLoggedModels.OrderBy(
+                    field_name=clause["field_name"],
+                    ascending=clause.get("ascending", True),
+                    dataset_name=clause.get("dataset_name"),
+                    dataset_digest=clause.get("dataset_digest"),
+                )
+                for clause in order_by
+            ]
+            if order_by
+            else [],
+            page_token=page_token,
+        )
+        response = self.service.call_endpoint(get_lib().TrackingServiceSearchLoggedModels, request)
+        return PagedList(
+            [LoggedModel.from_proto(model) for model in response.models],
+            (response.next_page_token or None),
+        )
+
 
 def TrackingStore(cls):
     return type(cls.__name__, (_TrackingStore, cls), {})
@@ -0,0 +1,278 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+const (
+	directoryPermissions = 0o755
+	uploadFilePattern    = ".upload-*"
+	// multipartUploadsFolder holds the parts of multipart uploads in progress. It lives below the root,
+	// so that assembled artifacts can be moved into place, but is not reachable as an artifact itself.
+	multipartUploadsFolder = ".mlflow-multipart-uploads"
+)
+
+// LocalArtifactRepository stores artifacts as plain files below a root directory.
+type LocalArtifactRepository struct {
+	root string
+}
+
+// NewLocalArtifactRepository does not create root, this happens on the first upload. Listing
+// artifacts of a run, which is rooted at its own directory, thus does not write to disk.
+func NewLocalArtifactRepository(root string) (*LocalArtifactRepository, error) {
+	absoluteRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve artifact root %q: %w", root, err)
+	}
+
+	return &LocalArtifactRepository{
+		root: absoluteRoot,
+	}, nil
+}
+
+// resolve validates the artifact path and returns both its cleaned relative form and its location on disk.
+func (r LocalArtifactRepository) resolve(artifactPath string) (string, string, *contract.Error) {
+	cleaned, contractError := repository.ValidatePath(artifactPath)
+	if contractError != nil {
+		return "", "", contractError
+	}
+
+	if cleaned == multipartUploadsFolder || strings.HasPrefix(cleaned, multipartUploadsFolder+"/") {
+		return "", "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Invalid path: %q", artifactPath),
+		)
+	}
+
+	fullPath := filepath.Join(r.root, filepath.FromSlash(cleaned))
+
+	// ValidatePath already rejects `..`, this is a second line of defence against platform specific paths.
+	relativePath, err := filepath.Rel(r.root, fullPath)
+	if err != nil || relativePath == ".." || strings.HasPrefix(relativePath, ".."+string(filepath.Separator)) {
+		return "", "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Invalid path: %q", artifactPath),
+		)
+	}
+
+	return cleaned, fullPath, nil
+}
+
+func (r LocalArtifactRepository) DownloadArtifact(
+	_ context.Context, artifactPath string,
+) (io.ReadCloser, int64, *contract.Error) {
+	_, fullPath, contractError := r.resolve(artifactPath)
+	if contractError != nil {
+		return nil, 0, contractError
+	}
+
+	file, err := os.Open(fullPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, 0, contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("Artifact %q does not exist", artifactPath),
+			)
+		}
+
+		return nil, 0, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to open artifact %q", artifactPath),
+			err,
+		)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+
+		return nil, 0, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to stat artifact %q", artifactPath),
+			err,
+		)
+	}
+
+	if info.IsDir() {
+		file.Close()
+
+		return nil, 0, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Artifact %q is a directory", artifactPath),
+		)
+	}
+
+	return file, info.Size(), nil
+}
+
+// writeAtomically writes to a temporary file next to fullPath and renames it once write succeeded,
+// so readers never observe a partially written file.
+func writeAtomically(fullPath string, write func(writer io.Writer) error) error {
+	directory := filepath.Dir(fullPath)
+	if err := os.MkdirAll(directory, directoryPermissions); err != nil {
+		return fmt.Errorf("failed to create directory %q: %w", directory, err)
+	}
+
+	temporaryFile, err := os.CreateTemp(directory, uploadFilePattern)
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file in %q: %w", directory, err)
+	}
+
+	err = write(temporaryFile)
+
+	if closeErr := temporaryFile.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to close %q: %w", temporaryFile.Name(), closeErr)
+	}
+
+	if err == nil {
+		if renameErr := os.Rename(temporaryFile.Name(), fullPath); renameErr != nil {
+			err = fmt.Errorf("failed to move %q to %q: %w", temporaryFile.Name(), fullPath, renameErr)
+		}
+	}
+
+	if err != nil {
+		os.Remove(temporaryFile.Name())
+
+		return err
+	}
+
+	return nil
+}
+
+func copyFrom(reader io.Reader) func(writer io.Writer) error {
+	return func(writer io.Writer) error {
+		if _, err := io.Copy(writer, reader); err != nil {
+			return fmt.Errorf("failed to copy content: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func (r LocalArtifactRepository) UploadArtifact(
+	_ context.Context, artifactPath string, reader io.Reader,
+) *contract.Error {
+	cleaned, fullPath, contractError := r.resolve(artifactPath)
+	if contractError != nil {
+		return contractError
+	}
+
+	if cleaned == "" {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"Artifact path must point to a file",
+		)
+	}
+
+	if err := writeAtomically(fullPath, copyFrom(reader)); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to write artifact %q", artifactPath),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// ListArtifacts returns an empty list when path does not exist or is not a directory.
+func (r LocalArtifactRepository) ListArtifacts(
+	_ context.Context, artifactPath string,
+) ([]*entities.FileInfo, *contract.Error) {
+	cleaned, fullPath, contractError := r.resolve(artifactPath)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	entries, err := os.ReadDir(fullPath)
+	if err != nil {
+		if !isDirectory(fullPath) {
+			return []*entities.FileInfo{}, nil
+		}
+
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to list artifacts in %q", artifactPath),
+			err,
+		)
+	}
+
+	files := make([]*entities.FileInfo, 0, len(entries))
+
+	for _, entry := range entries {
+		if cleaned == "" && entry.Name() == multipartUploadsFolder {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			// the entry was removed while listing.
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to stat artifact %q", path.Join(cleaned, entry.Name())),
+				err,
+			)
+		}
+
+		file := &entities.FileInfo{
+			Path:  path.Join(cleaned, entry.Name()),
+			IsDir: info.IsDir(),
+		}
+
+		if !file.IsDir {
+			file.FileSize = info.Size()
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// DeleteArtifact is a no-op when path does not exist.
+func (r LocalArtifactRepository) DeleteArtifact(_ context.Context, artifactPath string) *contract.Error {
+	cleaned, fullPath, contractError := r.resolve(artifactPath)
+	if contractError != nil {
+		return contractError
+	}
+
+	if cleaned == "" {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"Deleting the artifact root is not allowed",
+		)
+	}
+
+	if err := os.RemoveAll(fullPath); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to delete artifact %q", artifactPath),
+			err,
+		)
+	}
+
+	return nil
+}
+
+func isDirectory(fullPath string) bool {
+	info, err := os.Stat(fullPath)
+
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,173 @@
+package local_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/local"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+func newRepository(t *testing.T) (*local.LocalArtifactRepository, string) {
+	t.Helper()
+
+	root := t.TempDir()
+
+	repository, err := local.NewLocalArtifactRepository(root)
+	require.NoError(t, err)
+
+	return repository, root
+}
+
+func TestUploadDownloadAndList(t *testing.T) {
+	t.Parallel()
+
+	repository, root := newRepository(t)
+	ctx := context.Background()
+
+	require.Nil(t, repository.UploadArtifact(ctx, "1/run/model/MLmodel", strings.NewReader("flavors: {}")))
+	require.Nil(t, repository.UploadArtifact(ctx, "1/run/model/data/weights.bin", strings.NewReader("0123456789")))
+
+	content, err := os.ReadFile(filepath.Join(root, "1", "run", "model", "MLmodel"))
+	require.NoError(t, err)
+	assert.Equal(t, "flavors: {}", string(content))
+
+	reader, size, contractError := repository.DownloadArtifact(ctx, "1/run/model/data/weights.bin")
+	require.Nil(t, contractError)
+
+	downloaded, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, "0123456789", string(downloaded))
+	assert.Equal(t, int64(10), size)
+
+	files, contractError := repository.ListArtifacts(ctx, "1/run/model")
+	require.Nil(t, contractError)
+	assert.Equal(t, []*entities.FileInfo{
+		{Path: "1/run/model/MLmodel", IsDir: false, FileSize: 11},
+		{Path: "1/run/model/data", IsDir: true},
+	}, files)
+}
+
+func TestUploadReplacesExistingArtifact(t *testing.T) {
+	t.Parallel()
+
+	repository, root := newRepository(t)
+	ctx := context.Background()
+
+	require.Nil(t, repository.UploadArtifact(ctx, "file.txt", strings.NewReader("first")))
+	require.Nil(t, repository.UploadArtifact(ctx, "file.txt", strings.NewReader("second")))
+
+	entries, err := os.ReadDir(root)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	content, err := os.ReadFile(filepath.Join(root, "file.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(content))
+}
+
+func TestListMissingOrFilePath(t *testing.T) {
+	t.Parallel()
+
+	repository, _ := newRepository(t)
+	ctx := context.Background()
+
+	require.Nil(t, repository.UploadArtifact(ctx, "file.txt", strings.NewReader("content")))
+
+	for _, artifactPath := range []string{"missing", "file.txt"} {
+		files, contractError := repository.ListArtifacts(ctx, artifactPath)
+		require.Nil(t, contractError)
+		assert.Empty(t, files)
+	}
+}
+
+func TestDownloadErrors(t *testing.T) {
+	t.Parallel()
+
+	repository, _ := newRepository(t)
+	ctx := context.Background()
+
+	require.Nil(t, repository.UploadArtifact(ctx, "dir/file.txt", strings.NewReader("content")))
+
+	_, _, contractError := repository.DownloadArtifact(ctx, "dir/missing.txt")
+	require.NotNil(t, contractError)
+	assert.Equal(t, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, protos.ErrorCode(contractError.Code))
+
+	_, _, contractError = repository.DownloadArtifact(ctx, "dir")
+	require.NotNil(t, contractError)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractError.Code))
+}
+
+func TestDeleteArtifact(t *testing.T) {
+	t.Parallel()
+
+	repository, root := newRepository(t)
+	ctx := context.Background()
+
+	require.Nil(t, repository.UploadArtifact(ctx, "dir/nested/file.txt", strings.NewReader("content")))
+	require.Nil(t, repository.UploadArtifact(ctx, "other.txt", strings.NewReader("content")))
+
+	require.Nil(t, repository.DeleteArtifact(ctx, "dir"))
+	require.Nil(t, repository.DeleteArtifact(ctx, "dir"))
+
+	_, err := os.Stat(filepath.Join(root, "dir"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = os.Stat(filepath.Join(root, "other.txt"))
+	require.NoError(t, err)
+
+	contractError := repository.DeleteArtifact(ctx, "")
+	require.NotNil(t, contractError)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractError.Code))
+}
+
+func TestPathTraversalIsRejected(t *testing.T) {
+	t.Parallel()
+
+	parent := t.TempDir()
+	root := filepath.Join(parent, "artifacts")
+
+	repository, err := local.NewLocalArtifactRepository(root)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(parent, "secret.txt"), []byte("secret"), 0o600))
+
+	ctx := context.Background()
+
+	for _, artifactPath := range []string{
+		"../secret.txt",
+		"dir/../../secret.txt",
+		"/etc/passwd",
+		"%2e%2e/secret.txt",
+		"%252e%252e/secret.txt",
+		"..\\secret.txt",
+		"C:/Windows",
+		"file:///etc/passwd",
+	} {
+		_, _, contractError := repository.DownloadArtifact(ctx, artifactPath)
+		require.NotNil(t, contractError, artifactPath)
+		assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractError.Code), artifactPath)
+
+		contractError = repository.UploadArtifact(ctx, artifactPath, strings.NewReader("overwritten"))
+		require.NotNil(t, contractError, artifactPath)
+
+		_, contractError = repository.ListArtifacts(ctx, artifactPath)
+		require.NotNil(t, contractError, artifactPath)
+
+		contractError = repository.DeleteArtifact(ctx, artifactPath)
+		require.NotNil(t, contractError, artifactPath)
+	}
+
+	content, err := os.ReadFile(filepath.Join(parent, "secret.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "secret", string(content))
+}
@@ -0,0 +1,370 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec // Content-MD5 is only an integrity check
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+const (
+	uploadIDBytes          = 16
+	uploadMetadataFileName = "upload.json"
+	partFilePrefix         = "part-"
+	filePermissions        = 0o644
+)
+
+var (
+	uploadIDRegex = regexp.MustCompile(`^[0-9a-f]{32}$`)
+	// errChecksumMismatch is returned when an uploaded part does not match the checksums given by the client.
+	errChecksumMismatch = errors.New("checksum mismatch")
+	// errETagMismatch is returned when a staged part does not match the ETag given by the client.
+	errETagMismatch = errors.New("ETag mismatch")
+)
+
+// multipartUpload is persisted next to the staged parts, so uploads survive server restarts.
+type multipartUpload struct {
+	Path     string `json:"path"`
+	NumParts int64  `json:"num_parts"`
+}
+
+func (r LocalArtifactRepository) uploadDirectory(uploadID string) (string, *contract.Error) {
+	if !uploadIDRegex.MatchString(uploadID) {
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Invalid upload ID: %q", uploadID),
+		)
+	}
+
+	return filepath.Join(r.root, multipartUploadsFolder, uploadID), nil
+}
+
+func partFileName(partNumber int64) string {
+	return partFilePrefix + strconv.FormatInt(partNumber, 10)
+}
+
+// getMultipartUpload loads an upload in progress together with its staging directory.
+func (r LocalArtifactRepository) getMultipartUpload(uploadID string) (string, *multipartUpload, *contract.Error) {
+	directory, contractError := r.uploadDirectory(uploadID)
+	if contractError != nil {
+		return "", nil, contractError
+	}
+
+	content, err := os.ReadFile(filepath.Join(directory, uploadMetadataFileName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", nil, contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("Multipart upload %q does not exist", uploadID),
+			)
+		}
+
+		return "", nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to read multipart upload %q", uploadID),
+			err,
+		)
+	}
+
+	var upload multipartUpload
+	if err := json.Unmarshal(content, &upload); err != nil {
+		return "", nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to parse multipart upload %q", uploadID),
+			err,
+		)
+	}
+
+	return directory, &upload, nil
+}
+
+// getMultipartUploadFor loads an upload in progress, which has to be created for the artifact path.
+func (r LocalArtifactRepository) getMultipartUploadFor(
+	artifactPath, uploadID string,
+) (string, string, *multipartUpload, *contract.Error) {
+	cleaned, fullPath, contractError := r.resolve(artifactPath)
+	if contractError != nil {
+		return "", "", nil, contractError
+	}
+
+	directory, upload, contractError := r.getMultipartUpload(uploadID)
+	if contractError != nil {
+		return "", "", nil, contractError
+	}
+
+	if cleaned != upload.Path {
+		return "", "", nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Multipart upload %q does not belong to %q", uploadID, artifactPath),
+		)
+	}
+
+	return fullPath, directory, upload, nil
+}
+
+func (r LocalArtifactRepository) CreateMultipartUpload(
+	_ context.Context, artifactPath string, numParts int64,
+) (*entities.MultipartUpload, *contract.Error) {
+	cleaned, _, contractError := r.resolve(artifactPath)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	if cleaned == "" {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"Artifact path must point to a file",
+		)
+	}
+
+	randomBytes := make([]byte, uploadIDBytes)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to generate upload ID", err)
+	}
+
+	uploadID := hex.EncodeToString(randomBytes)
+
+	directory, contractError := r.uploadDirectory(uploadID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	content, err := json.Marshal(multipartUpload{Path: cleaned, NumParts: numParts})
+	if err != nil {
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to encode multipart upload", err)
+	}
+
+	if err := os.MkdirAll(directory, directoryPermissions); err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to create staging directory for %q", artifactPath),
+			err,
+		)
+	}
+
+	if err := os.WriteFile(filepath.Join(directory, uploadMetadataFileName), content, filePermissions); err != nil {
+		os.RemoveAll(directory)
+
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to create multipart upload for %q", artifactPath),
+			err,
+		)
+	}
+
+	return &entities.MultipartUpload{
+		UploadID: uploadID,
+	}, nil
+}
+
+// verifyChecksum compares the digest of the content read by hash with the one given by the
+// client, if any.
+func verifyChecksum(name string, expected []byte, hash hash.Hash) error {
+	if actual := hash.Sum(nil); expected != nil && !bytes.Equal(expected, actual) {
+		return fmt.Errorf(
+			"%w: the %s of the part is %s", errChecksumMismatch, name, base64.StdEncoding.EncodeToString(actual),
+		)
+	}
+
+	return nil
+}
+
+// UploadPart returns the hex encoded SHA-256 checksum of the part as its ETag. The part only
+// replaces the staged one once it matched the checksums given by the client.
+func (r LocalArtifactRepository) UploadPart(
+	_ context.Context, uploadID string, partNumber int64, reader io.Reader,
+	checksums entities.MultipartUploadPartChecksums,
+) (string, *contract.Error) {
+	directory, upload, contractError := r.getMultipartUpload(uploadID)
+	if contractError != nil {
+		return "", contractError
+	}
+
+	if partNumber < 1 || partNumber > upload.NumParts {
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Part number must be between 1 and %d, got %d", upload.NumParts, partNumber),
+		)
+	}
+
+	md5Hash := md5.New() //nolint:gosec
+	sha256Hash := sha256.New()
+
+	err := writeAtomically(filepath.Join(directory, partFileName(partNumber)), func(writer io.Writer) error {
+		if err := copyFrom(reader)(io.MultiWriter(writer, md5Hash, sha256Hash)); err != nil {
+			return err
+		}
+
+		if err := verifyChecksum("MD5", checksums.MD5, md5Hash); err != nil {
+			return err
+		}
+
+		return verifyChecksum("SHA-256", checksums.SHA256, sha256Hash)
+	})
+	if err != nil {
+		if errors.Is(err, errChecksumMismatch) {
+			return "", contract.NewErrorWith(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Part %d of multipart upload %q does not match its checksum", partNumber, uploadID),
+				err,
+			)
+		}
+
+		return "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to write part %d of multipart upload %q", partNumber, uploadID),
+			err,
+		)
+	}
+
+	return hex.EncodeToString(sha256Hash.Sum(nil)), nil
+}
+
+func validateParts(parts []*entities.MultipartUploadPart, numParts int64) *contract.Error {
+	if len(parts) == 0 {
+		return contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE, "At least one part is required")
+	}
+
+	previousPartNumber := int64(0)
+
+	for _, part := range parts {
+		if part.PartNumber <= previousPartNumber || part.PartNumber > numParts {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"Parts must be in ascending order with part numbers between 1 and %d, got %d",
+					numParts,
+					part.PartNumber,
+				),
+			)
+		}
+
+		if part.ETag == "" {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Missing ETag for part %d", part.PartNumber),
+			)
+		}
+
+		previousPartNumber = part.PartNumber
+	}
+
+	return nil
+}
+
+func appendPart(writer io.Writer, partFile string, part *entities.MultipartUploadPart) error {
+	file, err := os.Open(partFile)
+	if err != nil {
+		return fmt.Errorf("failed to open part %d: %w", part.PartNumber, err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(writer, hash), file); err != nil {
+		return fmt.Errorf("failed to copy part %d: %w", part.PartNumber, err)
+	}
+
+	// ETag headers are quoted, clients usually pass them on unchanged.
+	if hex.EncodeToString(hash.Sum(nil)) != strings.Trim(part.ETag, `"`) {
+		return fmt.Errorf("%w for part %d", errETagMismatch, part.PartNumber)
+	}
+
+	return nil
+}
+
+// CompleteMultipartUpload checks every part against the ETag the client got for it while
+// assembling them, so a part uploaded again meanwhile is not assembled unnoticed. The artifact
+// is only moved into place once all of them matched.
+func (r LocalArtifactRepository) CompleteMultipartUpload(
+	_ context.Context, artifactPath, uploadID string, parts []*entities.MultipartUploadPart,
+) *contract.Error {
+	fullPath, directory, upload, contractError := r.getMultipartUploadFor(artifactPath, uploadID)
+	if contractError != nil {
+		return contractError
+	}
+
+	if contractError := validateParts(parts, upload.NumParts); contractError != nil {
+		return contractError
+	}
+
+	for _, part := range parts {
+		if _, err := os.Stat(filepath.Join(directory, partFileName(part.PartNumber))); err != nil {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Part %d of multipart upload %q has not been uploaded", part.PartNumber, uploadID),
+			)
+		}
+	}
+
+	err := writeAtomically(fullPath, func(writer io.Writer) error {
+		for _, part := range parts {
+			if err := appendPart(writer, filepath.Join(directory, partFileName(part.PartNumber)), part); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errETagMismatch) {
+			return contract.NewErrorWith(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("The parts of multipart upload %q do not match their ETags", uploadID),
+				err,
+			)
+		}
+
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to complete multipart upload %q", uploadID),
+			err,
+		)
+	}
+
+	if err := os.RemoveAll(directory); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to clean up multipart upload %q", uploadID),
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (r LocalArtifactRepository) AbortMultipartUpload(
+	_ context.Context, artifactPath, uploadID string,
+) *contract.Error {
+	_, directory, _, contractError := r.getMultipartUploadFor(artifactPath, uploadID)
+	if contractError != nil {
+		return contractError
+	}
+
+	if err := os.RemoveAll(directory); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to abort multipart upload %q", uploadID),
+			err,
+		)
+	}
+
+	return nil
+}
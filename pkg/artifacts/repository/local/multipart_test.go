@@ -0,0 +1,204 @@
+package local_test
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+var noChecksums = entities.MultipartUploadPartChecksums{}
+
+func TestMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	repository, root := newRepository(t)
+	ctx := context.Background()
+
+	upload, contractError := repository.CreateMultipartUpload(ctx, "1/run/checkpoint.bin", 3)
+	require.Nil(t, contractError)
+	require.NotEmpty(t, upload.UploadID)
+
+	// parts can be uploaded in any order and uploading a part again replaces it.
+	etag3, contractError := repository.UploadPart(ctx, upload.UploadID, 3, strings.NewReader("!"), noChecksums)
+	require.Nil(t, contractError)
+	_, contractError = repository.UploadPart(ctx, upload.UploadID, 1, strings.NewReader("interrupted"), noChecksums)
+	require.Nil(t, contractError)
+	etag1, contractError := repository.UploadPart(ctx, upload.UploadID, 1, strings.NewReader("hello "), noChecksums)
+	require.Nil(t, contractError)
+	etag2, contractError := repository.UploadPart(ctx, upload.UploadID, 2, strings.NewReader("world"), noChecksums)
+	require.Nil(t, contractError)
+
+	// staged parts are not visible as artifacts.
+	files, contractError := repository.ListArtifacts(ctx, "")
+	require.Nil(t, contractError)
+	assert.Empty(t, files)
+
+	parts := []*entities.MultipartUploadPart{
+		{PartNumber: 1, ETag: etag1},
+		{PartNumber: 2, ETag: `"` + etag2 + `"`},
+		{PartNumber: 3, ETag: etag3},
+	}
+
+	contractError = repository.CompleteMultipartUpload(ctx, "1/run/checkpoint.bin", upload.UploadID, parts)
+	require.Nil(t, contractError)
+
+	content, err := os.ReadFile(filepath.Join(root, "1", "run", "checkpoint.bin"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello world!", string(content))
+
+	_, contractError = repository.UploadPart(ctx, upload.UploadID, 1, strings.NewReader("late"), noChecksums)
+	require.NotNil(t, contractError)
+	assert.Equal(t, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, protos.ErrorCode(contractError.Code))
+}
+
+func TestCompleteMultipartUploadETagMismatch(t *testing.T) {
+	t.Parallel()
+
+	repository, root := newRepository(t)
+	ctx := context.Background()
+
+	upload, contractError := repository.CreateMultipartUpload(ctx, "file.bin", 2)
+	require.Nil(t, contractError)
+
+	etag1, contractError := repository.UploadPart(ctx, upload.UploadID, 1, strings.NewReader("first"), noChecksums)
+	require.Nil(t, contractError)
+	etag2, contractError := repository.UploadPart(ctx, upload.UploadID, 2, strings.NewReader("second"), noChecksums)
+	require.Nil(t, contractError)
+
+	contractError = repository.CompleteMultipartUpload(ctx, "file.bin", upload.UploadID, []*entities.MultipartUploadPart{
+		{PartNumber: 1, ETag: etag1},
+		{PartNumber: 2, ETag: etag1},
+	})
+	require.NotNil(t, contractError)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractError.Code))
+
+	_, err := os.Stat(filepath.Join(root, "file.bin"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// the staged parts are kept, so the upload can still be completed.
+	contractError = repository.CompleteMultipartUpload(ctx, "file.bin", upload.UploadID, []*entities.MultipartUploadPart{
+		{PartNumber: 1, ETag: etag1},
+		{PartNumber: 2, ETag: etag2},
+	})
+	require.Nil(t, contractError)
+}
+
+func TestUploadPartChecksums(t *testing.T) {
+	t.Parallel()
+
+	repository, _ := newRepository(t)
+	ctx := context.Background()
+
+	upload, contractError := repository.CreateMultipartUpload(ctx, "file.bin", 1)
+	require.Nil(t, contractError)
+
+	md5Checksum := md5.Sum([]byte("content")) //nolint:gosec
+	sha256Checksum := sha256.Sum256([]byte("content"))
+
+	etag, contractError := repository.UploadPart(
+		ctx, upload.UploadID, 1, strings.NewReader("content"),
+		entities.MultipartUploadPartChecksums{MD5: md5Checksum[:], SHA256: sha256Checksum[:]},
+	)
+	require.Nil(t, contractError)
+
+	// a part which doesn't match the checksums given with it does not replace the staged part.
+	for name, checksums := range map[string]entities.MultipartUploadPartChecksums{
+		"MD5":    {MD5: md5Checksum[:]},
+		"SHA256": {SHA256: sha256Checksum[:]},
+	} {
+		_, contractError = repository.UploadPart(ctx, upload.UploadID, 1, strings.NewReader("corrupted"), checksums)
+		require.NotNil(t, contractError, name)
+		assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractError.Code), name)
+	}
+
+	contractError = repository.CompleteMultipartUpload(ctx, "file.bin", upload.UploadID, []*entities.MultipartUploadPart{
+		{PartNumber: 1, ETag: etag},
+	})
+	require.Nil(t, contractError)
+}
+
+func TestCompleteMultipartUploadInvalidParts(t *testing.T) {
+	t.Parallel()
+
+	repository, _ := newRepository(t)
+	ctx := context.Background()
+
+	upload, contractError := repository.CreateMultipartUpload(ctx, "file.bin", 2)
+	require.Nil(t, contractError)
+
+	etag1, contractError := repository.UploadPart(ctx, upload.UploadID, 1, strings.NewReader("first"), noChecksums)
+	require.Nil(t, contractError)
+
+	for name, parts := range map[string][]*entities.MultipartUploadPart{
+		"NoParts":       {},
+		"MissingPart":   {{PartNumber: 1, ETag: etag1}, {PartNumber: 2, ETag: etag1}},
+		"OutOfOrder":    {{PartNumber: 2, ETag: etag1}, {PartNumber: 1, ETag: etag1}},
+		"OutOfRange":    {{PartNumber: 3, ETag: etag1}},
+		"MissingETag":   {{PartNumber: 1}},
+		"DuplicatePart": {{PartNumber: 1, ETag: etag1}, {PartNumber: 1, ETag: etag1}},
+	} {
+		contractError := repository.CompleteMultipartUpload(ctx, "file.bin", upload.UploadID, parts)
+		require.NotNil(t, contractError, name)
+		assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractError.Code), name)
+	}
+
+	_, contractError = repository.UploadPart(ctx, upload.UploadID, 3, strings.NewReader("third"), noChecksums)
+	require.NotNil(t, contractError)
+
+	contractError = repository.CompleteMultipartUpload(ctx, "other.bin", upload.UploadID, []*entities.MultipartUploadPart{
+		{PartNumber: 1, ETag: etag1},
+	})
+	require.NotNil(t, contractError)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractError.Code))
+}
+
+func TestAbortMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	repository, _ := newRepository(t)
+	ctx := context.Background()
+
+	upload, contractError := repository.CreateMultipartUpload(ctx, "file.bin", 1)
+	require.Nil(t, contractError)
+
+	etag, contractError := repository.UploadPart(ctx, upload.UploadID, 1, strings.NewReader("content"), noChecksums)
+	require.Nil(t, contractError)
+
+	require.Nil(t, repository.AbortMultipartUpload(ctx, "file.bin", upload.UploadID))
+
+	contractError = repository.CompleteMultipartUpload(ctx, "file.bin", upload.UploadID, []*entities.MultipartUploadPart{
+		{PartNumber: 1, ETag: etag},
+	})
+	require.NotNil(t, contractError)
+	assert.Equal(t, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, protos.ErrorCode(contractError.Code))
+
+	contractError = repository.AbortMultipartUpload(ctx, "file.bin", "../../etc")
+	require.NotNil(t, contractError)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractError.Code))
+}
+
+func TestStagingFolderIsNotAnArtifact(t *testing.T) {
+	t.Parallel()
+
+	repository, _ := newRepository(t)
+	ctx := context.Background()
+
+	_, contractError := repository.CreateMultipartUpload(ctx, "file.bin", 1)
+	require.Nil(t, contractError)
+
+	_, contractError = repository.ListArtifacts(ctx, ".mlflow-multipart-uploads")
+	require.NotNil(t, contractError)
+
+	contractError = repository.DeleteArtifact(ctx, ".mlflow-multipart-uploads")
+	require.NotNil(t, contractError)
+}
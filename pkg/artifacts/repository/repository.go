@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// ArtifactRepository stores and serves artifact files below a root location.
+// Every path is relative to that root and uses forward slashes.
+type ArtifactRepository interface {
+	// DownloadArtifact opens the artifact file at path together with its size in bytes.
+	// The caller is responsible for closing the returned reader.
+	DownloadArtifact(ctx context.Context, path string) (io.ReadCloser, int64, *contract.Error)
+	// UploadArtifact writes the content of reader to path, replacing any existing artifact.
+	UploadArtifact(ctx context.Context, path string, reader io.Reader) *contract.Error
+	// ListArtifacts returns the direct children of the directory at path.
+	ListArtifacts(ctx context.Context, path string) ([]*entities.FileInfo, *contract.Error)
+	// DeleteArtifact removes the artifact file or directory at path.
+	DeleteArtifact(ctx context.Context, path string) *contract.Error
+}
+
+const maxDecodeIterations = 10
+
+// decodePath repeatedly unescapes the path, so that encoded traversal sequences are detected.
+func decodePath(artifactPath string) (string, bool) {
+	current := artifactPath
+
+	for range maxDecodeIterations {
+		decoded, err := url.PathUnescape(current)
+		if err != nil {
+			return "", false
+		}
+
+		if decoded == current {
+			return current, true
+		}
+
+		current = decoded
+	}
+
+	return "", false
+}
+
+func isAbsolute(artifactPath string) bool {
+	if strings.HasPrefix(artifactPath, "/") {
+		return true
+	}
+
+	// Windows drive letters, e.g. `C:` or `c:/`.
+	return len(artifactPath) >= 2 && artifactPath[1] == ':'
+}
+
+// ValidatePath makes sure an artifact path cannot escape the root of the repository and
+// returns its cleaned form. The root itself is represented by an empty string.
+// Percent-encoded sequences are decoded before validation, so `%2e%2e` is rejected as well.
+func ValidatePath(artifactPath string) (string, *contract.Error) {
+	invalidPathError := contract.NewError(
+		protos.ErrorCode_INVALID_PARAMETER_VALUE,
+		fmt.Sprintf("Invalid path: %q", artifactPath),
+	)
+
+	decoded, ok := decodePath(artifactPath)
+	if !ok {
+		return "", invalidPathError
+	}
+
+	if strings.ContainsAny(decoded, "\\\x00") || isAbsolute(decoded) || strings.HasPrefix(decoded, "file:") {
+		return "", invalidPathError
+	}
+
+	for _, component := range strings.Split(decoded, "/") {
+		if component == ".." {
+			return "", invalidPathError
+		}
+	}
+
+	cleaned := path.Clean(artifactPath)
+	if cleaned == "." {
+		return "", nil
+	}
+
+	return cleaned, nil
+}
+
+// MultipartUploadRepository is implemented by repositories able to assemble an artifact
+// from parts uploaded independently of each other.
+type MultipartUploadRepository interface {
+	// CreateMultipartUpload starts an upload of numParts parts to path.
+	CreateMultipartUpload(ctx context.Context, path string, numParts int64) (*entities.MultipartUpload, *contract.Error)
+	// UploadPart stores a single part, if it matches the checksums, and returns its ETag. Uploading
+	// a part again replaces it.
+	UploadPart(
+		ctx context.Context, uploadID string, partNumber int64, reader io.Reader,
+		checksums entities.MultipartUploadPartChecksums,
+	) (string, *contract.Error)
+	// CompleteMultipartUpload assembles the parts, in the given order, into the artifact at path.
+	CompleteMultipartUpload(
+		ctx context.Context, path, uploadID string, parts []*entities.MultipartUploadPart,
+	) *contract.Error
+	// AbortMultipartUpload discards the upload and every part uploaded so far.
+	AbortMultipartUpload(ctx context.Context, path, uploadID string) *contract.Error
+}
@@ -0,0 +1,74 @@
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository"
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/local"
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/s3"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+const proxiedArtifactsScheme = "mlflow-artifacts"
+
+// ErrUnsupportedURI is returned for artifact URIs which cannot be served by the Go server.
+var ErrUnsupportedURI = errors.New("unsupported artifact URI")
+
+// NewArtifactRepository creates the repository rooted at uri. Plain paths and `file://` URIs are
+// served from the local filesystem and `s3://` URIs from an S3 compatible object store configured
+// through the environment, when it holds static credentials. `mlflow-artifacts:/` URIs are proxied
+// by the server, so they are resolved relative to artifactsDestination.
+func NewArtifactRepository(uri, artifactsDestination string) (repository.ArtifactRepository, error) {
+	parsedURI, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse artifact URI %q: %w", uri, err)
+	}
+
+	switch parsedURI.Scheme {
+	case "":
+		return local.NewLocalArtifactRepository(uri) //nolint:wrapcheck
+	case "file":
+		return local.NewLocalArtifactRepository(parsedURI.Path) //nolint:wrapcheck
+	case "s3":
+		config := s3.NewConfigFromEnv()
+		// the Python server resolves the credentials from the rest of the AWS credential chain.
+		if !config.HasStaticCredentials() {
+			return nil, fmt.Errorf("%w %q: no static AWS credentials are configured", ErrUnsupportedURI, uri)
+		}
+
+		return s3.NewS3ArtifactRepository(uri, config) //nolint:wrapcheck
+	case proxiedArtifactsScheme:
+		return newProxiedArtifactRepository(parsedURI, artifactsDestination)
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnsupportedURI, uri)
+	}
+}
+
+func newProxiedArtifactRepository(
+	parsedURI *url.URL, artifactsDestination string,
+) (repository.ArtifactRepository, error) {
+	// the destination itself must not be proxied, otherwise resolving it would never end.
+	if destination, err := url.Parse(artifactsDestination); err != nil || destination.Scheme == proxiedArtifactsScheme {
+		return nil, fmt.Errorf("%w: invalid artifacts destination %q", ErrUnsupportedURI, artifactsDestination)
+	}
+
+	// the host of `mlflow-artifacts://host:port/path` URIs is the server itself.
+	artifactPath, contractError := repository.ValidatePath(strings.TrimPrefix(parsedURI.Path, "/"))
+	if contractError != nil {
+		return nil, fmt.Errorf("%w %q: %s", ErrUnsupportedURI, parsedURI, contractError.Message)
+	}
+
+	if artifactPath == "" {
+		return NewArtifactRepository(artifactsDestination, "")
+	}
+
+	destination, err := utils.AppendToURIPath(artifactsDestination, artifactPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve artifact URI %q: %w", parsedURI, err)
+	}
+
+	return NewArtifactRepository(destination, "")
+}
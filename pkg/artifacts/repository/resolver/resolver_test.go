@@ -0,0 +1,70 @@
+package resolver_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/local"
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/resolver"
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/s3"
+)
+
+func TestNewArtifactRepository(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+
+	for _, uri := range []string{root, "file://" + root} {
+		repository, err := resolver.NewArtifactRepository(uri, "")
+		require.NoError(t, err, uri)
+		assert.IsType(t, &local.LocalArtifactRepository{}, repository, uri)
+	}
+
+	for _, uri := range []string{"gs://bucket/mlartifacts", "mlflow-artifacts:/1/run"} {
+		_, err := resolver.NewArtifactRepository(uri, "mlflow-artifacts:/")
+		require.ErrorIs(t, err, resolver.ErrUnsupportedURI, uri)
+	}
+}
+
+//nolint:paralleltest // the credentials are read from the environment.
+func TestS3ArtifactRepositoryRequiresStaticCredentials(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+
+	_, err := resolver.NewArtifactRepository("s3://bucket/mlartifacts", "")
+	require.ErrorIs(t, err, resolver.ErrUnsupportedURI)
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDEXAMPLE")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY")
+
+	repository, err := resolver.NewArtifactRepository("s3://bucket/mlartifacts", "")
+	require.NoError(t, err)
+	assert.IsType(t, &s3.S3ArtifactRepository{}, repository)
+}
+
+func TestProxiedArtifactsAreResolvedAgainstDestination(t *testing.T) {
+	t.Parallel()
+
+	destination := t.TempDir()
+
+	for _, uri := range []string{
+		"mlflow-artifacts:/1/run/artifacts",
+		"mlflow-artifacts://localhost:5000/1/run/artifacts",
+	} {
+		repository, err := resolver.NewArtifactRepository(uri, destination)
+		require.NoError(t, err, uri)
+		require.Nil(t, repository.UploadArtifact(context.Background(), "model.txt", strings.NewReader("x")), uri)
+
+		_, err = os.Stat(filepath.Join(destination, "1", "run", "artifacts", "model.txt"))
+		require.NoError(t, err, uri)
+	}
+
+	_, err := resolver.NewArtifactRepository("mlflow-artifacts:/1/../../etc", destination)
+	require.ErrorIs(t, err, resolver.ErrUnsupportedURI)
+}
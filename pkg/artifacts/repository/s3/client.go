@@ -0,0 +1,311 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// responseError is an error returned by the object store.
+type responseError struct {
+	XMLName    xml.Name `xml:"Error"`
+	StatusCode int      `xml:"-"`
+	Code       string   `xml:"Code"`
+	Message    string   `xml:"Message"`
+}
+
+func (e *responseError) Error() string {
+	return fmt.Sprintf("s3 request failed with status %d: %s %s", e.StatusCode, e.Code, e.Message)
+}
+
+func isNotFound(err error) bool {
+	var responseErr *responseError
+
+	return errors.As(err, &responseErr) && responseErr.StatusCode == http.StatusNotFound
+}
+
+type object struct {
+	Key  string `xml:"Key"`
+	Size int64  `xml:"Size"`
+}
+
+type listBucketResult struct {
+	Contents       []object `xml:"Contents"`
+	CommonPrefixes []struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"CommonPrefixes"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+type initiateMultipartUploadResult struct {
+	UploadID string `xml:"UploadId"`
+}
+
+type completedPart struct {
+	PartNumber int64  `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+	Parts   []completedPart `xml:"Part"`
+}
+
+// client implements the subset of the S3 REST API used by the artifact repository.
+type client struct {
+	httpClient *http.Client
+	endpoint   *url.URL
+	pathStyle  bool
+	bucket     string
+	signer     signer
+}
+
+func newClient(bucket string, config Config) (*client, error) {
+	endpointURL := config.EndpointURL
+	pathStyle := endpointURL != ""
+
+	if !pathStyle {
+		endpointURL = fmt.Sprintf("https://s3.%s.amazonaws.com", config.Region)
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(endpointURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse S3 endpoint %q: %w", endpointURL, err)
+	}
+
+	if !pathStyle {
+		endpoint.Host = bucket + "." + endpoint.Host
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert
+	if config.IgnoreTLS {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+	}
+
+	return &client{
+		httpClient: &http.Client{Transport: transport},
+		endpoint:   endpoint,
+		pathStyle:  pathStyle,
+		bucket:     bucket,
+		signer: signer{
+			region:          config.Region,
+			accessKeyID:     config.AccessKeyID,
+			secretAccessKey: config.SecretAccessKey,
+			sessionToken:    config.SessionToken,
+			now:             time.Now,
+		},
+	}, nil
+}
+
+func (c *client) objectURL(key string, query url.Values) *url.URL {
+	objectURL := *c.endpoint
+
+	objectPath := "/" + key
+	if c.pathStyle {
+		objectPath = "/" + c.bucket + objectPath
+	}
+
+	objectURL.Path += objectPath
+	objectURL.RawPath = uriEncode(objectURL.Path, false)
+	objectURL.RawQuery = encodeQuery(query)
+
+	return &objectURL
+}
+
+// do sends a signed request with a body of the given size.
+func (c *client) do(
+	ctx context.Context, method, key string, query url.Values, body io.Reader, size int64, payloadHash string,
+) (*http.Response, error) {
+	// an empty body has to be http.NoBody, otherwise it is sent chunked.
+	if size == 0 {
+		body = http.NoBody
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, "", body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.URL = c.objectURL(key, query)
+	req.Host = req.URL.Host
+	req.ContentLength = size
+
+	c.signer.sign(req, payloadHash)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s request: %w", method, err)
+	}
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		defer resp.Body.Close()
+
+		return nil, readError(resp)
+	}
+
+	return resp, nil
+}
+
+func readError(resp *http.Response) error {
+	responseErr := &responseError{StatusCode: resp.StatusCode}
+
+	content, err := io.ReadAll(resp.Body)
+	if err == nil && len(content) > 0 {
+		// the body is missing for HEAD requests and some errors, the status code is sufficient then.
+		_ = xml.Unmarshal(content, responseErr)
+	}
+
+	return responseErr
+}
+
+func (c *client) doBuffered(
+	ctx context.Context, method, key string, query url.Values, payload []byte,
+) ([]byte, error) {
+	resp, err := c.do(ctx, method, key, query, bytes.NewReader(payload), int64(len(payload)), hashSHA256(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	// some operations, e.g. CompleteMultipartUpload, report errors with a successful status code.
+	var responseErr responseError
+	if xml.Unmarshal(content, &responseErr) == nil {
+		responseErr.StatusCode = resp.StatusCode
+
+		return nil, &responseErr
+	}
+
+	return content, nil
+}
+
+func (c *client) getObject(ctx context.Context, key string) (io.ReadCloser, int64, error) {
+	resp, err := c.do(ctx, http.MethodGet, key, nil, nil, 0, hashSHA256(nil))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return resp.Body, resp.ContentLength, nil
+}
+
+func (c *client) putObject(ctx context.Context, key string, payload []byte) error {
+	_, err := c.doBuffered(ctx, http.MethodPut, key, nil, payload)
+
+	return err
+}
+
+func (c *client) deleteObject(ctx context.Context, key string) error {
+	_, err := c.doBuffered(ctx, http.MethodDelete, key, nil, nil)
+
+	return err
+}
+
+// listObjects returns a single page of objects below prefix. With a delimiter, keys containing
+// the delimiter after the prefix are grouped into common prefixes.
+func (c *client) listObjects(
+	ctx context.Context, prefix, delimiter, continuationToken string,
+) (*listBucketResult, error) {
+	query := url.Values{
+		"list-type": {"2"},
+		"prefix":    {prefix},
+	}
+
+	if delimiter != "" {
+		query.Set("delimiter", delimiter)
+	}
+
+	if continuationToken != "" {
+		query.Set("continuation-token", continuationToken)
+	}
+
+	content, err := c.doBuffered(ctx, http.MethodGet, "", query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result listBucketResult
+	if err := xml.Unmarshal(content, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse list objects response: %w", err)
+	}
+
+	return &result, nil
+}
+
+func (c *client) createMultipartUpload(ctx context.Context, key string) (string, error) {
+	content, err := c.doBuffered(ctx, http.MethodPost, key, url.Values{"uploads": {""}}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var result initiateMultipartUploadResult
+	if err := xml.Unmarshal(content, &result); err != nil {
+		return "", fmt.Errorf("failed to parse create multipart upload response: %w", err)
+	}
+
+	return result.UploadID, nil
+}
+
+func partQuery(uploadID string, partNumber int64) url.Values {
+	return url.Values{
+		"partNumber": {strconv.FormatInt(partNumber, 10)},
+		"uploadId":   {uploadID},
+	}
+}
+
+func (c *client) uploadPart(
+	ctx context.Context, key, uploadID string, partNumber int64, payload []byte,
+) (string, error) {
+	resp, err := c.do(
+		ctx,
+		http.MethodPut,
+		key,
+		partQuery(uploadID, partNumber),
+		bytes.NewReader(payload),
+		int64(len(payload)),
+		hashSHA256(payload),
+	)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	return resp.Header.Get("ETag"), nil
+}
+
+// presignUploadPart returns a URL the part can be uploaded to directly, without going through the server.
+func (c *client) presignUploadPart(key, uploadID string, partNumber int64, expires time.Duration) string {
+	return c.signer.presign(http.MethodPut, c.objectURL(key, partQuery(uploadID, partNumber)), expires)
+}
+
+func (c *client) completeMultipartUpload(
+	ctx context.Context, key, uploadID string, parts []completedPart,
+) error {
+	payload, err := xml.Marshal(completeMultipartUpload{Parts: parts})
+	if err != nil {
+		return fmt.Errorf("failed to encode complete multipart upload request: %w", err)
+	}
+
+	_, err = c.doBuffered(ctx, http.MethodPost, key, url.Values{"uploadId": {uploadID}}, payload)
+
+	return err
+}
+
+func (c *client) abortMultipartUpload(ctx context.Context, key, uploadID string) error {
+	_, err := c.doBuffered(ctx, http.MethodDelete, key, url.Values{"uploadId": {uploadID}}, nil)
+
+	return err
+}
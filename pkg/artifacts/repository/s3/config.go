@@ -0,0 +1,49 @@
+package s3
+
+import (
+	"os"
+	"strings"
+)
+
+const defaultRegion = "us-east-1"
+
+// Config holds the settings of the S3 compatible object store. They are read from the same
+// environment variables the Python server uses. Only static credentials are supported, the other
+// sources of the AWS credential chain, e.g. shared credential files or instance profiles, are left
+// to the Python server.
+type Config struct {
+	// EndpointURL points to an S3 compatible object store, objects are then addressed path-style.
+	// When empty, the AWS endpoint of the region is used with virtual-hosted-style addressing.
+	EndpointURL     string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// IgnoreTLS disables the verification of the server certificate.
+	IgnoreTLS bool
+}
+
+func NewConfigFromEnv() Config {
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+
+	if region == "" {
+		region = defaultRegion
+	}
+
+	return Config{
+		EndpointURL:     os.Getenv("MLFLOW_S3_ENDPOINT_URL"),
+		Region:          region,
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		IgnoreTLS:       strings.EqualFold(os.Getenv("MLFLOW_S3_IGNORE_TLS"), "true"),
+	}
+}
+
+// HasStaticCredentials tells whether an access key is configured, requests are otherwise anonymous.
+func (c Config) HasStaticCredentials() bool {
+	return c.AccessKeyID != "" && c.SecretAccessKey != ""
+}
@@ -0,0 +1,265 @@
+package s3_test
+
+import (
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	fakeBucket   = "artifacts"
+	fakePageSize = 2
+)
+
+type fakeUpload struct {
+	key   string
+	parts map[int64][]byte
+}
+
+// fakeS3 is an in-memory, path-style S3 bucket implementing the requests used by the repository.
+// Listings are paginated after fakePageSize entries, so that pagination is exercised.
+type fakeS3 struct {
+	mutex        sync.Mutex
+	objects      map[string][]byte
+	uploads      map[string]*fakeUpload
+	nextUploadID int
+}
+
+func newFakeS3(t *testing.T) (*fakeS3, *httptest.Server) {
+	t.Helper()
+
+	fake := &fakeS3{
+		objects: map[string][]byte{},
+		uploads: map[string]*fakeUpload{},
+	}
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	return fake, server
+}
+
+func etag(content []byte) string {
+	hash := md5.Sum(content) //nolint:gosec
+
+	return `"` + hex.EncodeToString(hash[:]) + `"`
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, code)
+}
+
+func writeXML(w http.ResponseWriter, value any) {
+	content, _ := xml.Marshal(value)
+	_, _ = w.Write(content)
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") == "" && r.URL.Query().Get("X-Amz-Signature") == "" {
+		writeError(w, http.StatusForbidden, "AccessDenied")
+
+		return
+	}
+
+	key, found := strings.CutPrefix(r.URL.Path, "/"+fakeBucket)
+	if !found {
+		writeError(w, http.StatusNotFound, "NoSuchBucket")
+
+		return
+	}
+
+	key = strings.TrimPrefix(key, "/")
+	query := r.URL.Query()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "IncompleteBody")
+
+		return
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && key == "" && query.Get("list-type") == "2":
+		f.list(w, query.Get("prefix"), query.Get("delimiter"), query.Get("continuation-token"))
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		f.nextUploadID++
+		uploadID := fmt.Sprintf("upload-%d", f.nextUploadID)
+		f.uploads[uploadID] = &fakeUpload{key: key, parts: map[int64][]byte{}}
+
+		fmt.Fprintf(w, "<InitiateMultipartUploadResult><UploadId>%s</UploadId></InitiateMultipartUploadResult>", uploadID)
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		upload, ok := f.uploads[query.Get("uploadId")]
+		if !ok || upload.key != key {
+			writeError(w, http.StatusNotFound, "NoSuchUpload")
+
+			return
+		}
+
+		partNumber, _ := strconv.ParseInt(query.Get("partNumber"), 10, 64)
+		upload.parts[partNumber] = body
+
+		w.Header().Set("ETag", etag(body))
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		f.complete(w, key, query.Get("uploadId"), body)
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		if _, ok := f.uploads[query.Get("uploadId")]; !ok {
+			writeError(w, http.StatusNotFound, "NoSuchUpload")
+
+			return
+		}
+
+		delete(f.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet:
+		content, ok := f.objects[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchKey")
+
+			return
+		}
+
+		_, _ = w.Write(content)
+	case r.Method == http.MethodPut:
+		f.objects[key] = body
+		w.Header().Set("ETag", etag(body))
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+type fakeListEntry struct {
+	key      string
+	isPrefix bool
+}
+
+func (f *fakeS3) list(w http.ResponseWriter, prefix, delimiter, continuationToken string) {
+	type content struct {
+		Key  string
+		Size int
+	}
+
+	type commonPrefix struct {
+		Prefix string
+	}
+
+	entries := []fakeListEntry{}
+
+	for key := range f.objects {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		if index := strings.Index(key[len(prefix):], delimiter); delimiter != "" && index >= 0 {
+			commonPrefix := key[:len(prefix)+index+len(delimiter)]
+			if !slices.Contains(entries, fakeListEntry{key: commonPrefix, isPrefix: true}) {
+				entries = append(entries, fakeListEntry{key: commonPrefix, isPrefix: true})
+			}
+
+			continue
+		}
+
+		entries = append(entries, fakeListEntry{key: key})
+	}
+
+	slices.SortFunc(entries, func(a, b fakeListEntry) int { return strings.Compare(a.key, b.key) })
+
+	start, _ := strconv.Atoi(continuationToken)
+	end := min(start+fakePageSize, len(entries))
+
+	result := struct {
+		XMLName               xml.Name `xml:"ListBucketResult"`
+		Contents              []content
+		CommonPrefixes        []commonPrefix
+		IsTruncated           bool
+		NextContinuationToken string `xml:",omitempty"`
+	}{
+		IsTruncated: end < len(entries),
+	}
+
+	if result.IsTruncated {
+		result.NextContinuationToken = strconv.Itoa(end)
+	}
+
+	for _, entry := range entries[start:end] {
+		if entry.isPrefix {
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: entry.key})
+		} else {
+			result.Contents = append(result.Contents, content{Key: entry.key, Size: len(f.objects[entry.key])})
+		}
+	}
+
+	writeXML(w, result)
+}
+
+func (f *fakeS3) complete(w http.ResponseWriter, key, uploadID string, body []byte) {
+	upload, ok := f.uploads[uploadID]
+	if !ok || upload.key != key {
+		writeError(w, http.StatusNotFound, "NoSuchUpload")
+
+		return
+	}
+
+	var request struct {
+		Parts []struct {
+			PartNumber int64
+			ETag       string
+		} `xml:"Part"`
+	}
+
+	if err := xml.Unmarshal(body, &request); err != nil {
+		writeError(w, http.StatusBadRequest, "MalformedXML")
+
+		return
+	}
+
+	var content []byte
+
+	for _, part := range request.Parts {
+		partContent, ok := upload.parts[part.PartNumber]
+		if !ok || etag(partContent) != part.ETag {
+			// like S3, the error is reported with a successful status code.
+			fmt.Fprint(w, "<Error><Code>InvalidPart</Code><Message>InvalidPart</Message></Error>")
+
+			return
+		}
+
+		content = append(content, partContent...)
+	}
+
+	f.objects[key] = content
+	delete(f.uploads, uploadID)
+
+	fmt.Fprintf(w, "<CompleteMultipartUploadResult><Key>%s</Key></CompleteMultipartUploadResult>", key)
+}
+
+func (f *fakeS3) object(key string) ([]byte, bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	content, ok := f.objects[key]
+
+	return content, ok
+}
+
+func (f *fakeS3) pendingUploads() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return len(f.uploads)
+}
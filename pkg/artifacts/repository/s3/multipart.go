@@ -0,0 +1,97 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// presignedURLExpiration is how long clients can upload a part to its presigned URL.
+const presignedURLExpiration = time.Hour
+
+// CreateMultipartUpload starts an upload in the object store. The parts are not proxied by
+// the server, clients upload them directly to the presigned URLs returned as credentials.
+func (r S3ArtifactRepository) CreateMultipartUpload(
+	ctx context.Context, artifactPath string, numParts int64,
+) (*entities.MultipartUpload, *contract.Error) {
+	key, contractError := r.key(artifactPath)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	uploadID, err := r.client.createMultipartUpload(ctx, key)
+	if err != nil {
+		return nil, newObjectStoreError(fmt.Sprintf("failed to create multipart upload for %q", artifactPath), err)
+	}
+
+	credentials := make([]*entities.MultipartUploadCredential, 0, numParts)
+	for partNumber := int64(1); partNumber <= numParts; partNumber++ {
+		credentials = append(credentials, &entities.MultipartUploadCredential{
+			URL:        r.client.presignUploadPart(key, uploadID, partNumber, presignedURLExpiration),
+			PartNumber: partNumber,
+		})
+	}
+
+	return &entities.MultipartUpload{
+		UploadID:    uploadID,
+		Credentials: credentials,
+	}, nil
+}
+
+// UploadPart is not supported, parts are uploaded to the presigned URLs instead.
+func (r S3ArtifactRepository) UploadPart(
+	context.Context, string, int64, io.Reader, entities.MultipartUploadPartChecksums,
+) (string, *contract.Error) {
+	return "", contract.NewError(
+		protos.ErrorCode_NOT_IMPLEMENTED,
+		"Parts of S3 multipart uploads must be uploaded to their presigned URL",
+	)
+}
+
+// CompleteMultipartUpload assembles the parts. The object store verifies the ETags it returned
+// for the uploaded parts against the ones reported by the client.
+func (r S3ArtifactRepository) CompleteMultipartUpload(
+	ctx context.Context, artifactPath, uploadID string, parts []*entities.MultipartUploadPart,
+) *contract.Error {
+	key, contractError := r.key(artifactPath)
+	if contractError != nil {
+		return contractError
+	}
+
+	if len(parts) == 0 {
+		return contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE, "At least one part is required")
+	}
+
+	completedParts := make([]completedPart, 0, len(parts))
+	for _, part := range parts {
+		completedParts = append(completedParts, completedPart{
+			PartNumber: part.PartNumber,
+			ETag:       `"` + strings.Trim(part.ETag, `"`) + `"`,
+		})
+	}
+
+	if err := r.client.completeMultipartUpload(ctx, key, uploadID, completedParts); err != nil {
+		return newObjectStoreError(fmt.Sprintf("failed to complete multipart upload %q", uploadID), err)
+	}
+
+	return nil
+}
+
+func (r S3ArtifactRepository) AbortMultipartUpload(ctx context.Context, artifactPath, uploadID string) *contract.Error {
+	key, contractError := r.key(artifactPath)
+	if contractError != nil {
+		return contractError
+	}
+
+	if err := r.client.abortMultipartUpload(ctx, key, uploadID); err != nil {
+		return newObjectStoreError(fmt.Sprintf("failed to abort multipart upload %q", uploadID), err)
+	}
+
+	return nil
+}
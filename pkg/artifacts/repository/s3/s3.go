@@ -0,0 +1,258 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// uploadPartSize is the size of the parts streamed uploads are split into. Smaller uploads
+// are sent with a single request. S3 requires parts of at least 5 MiB.
+const uploadPartSize = 8 * 1024 * 1024
+
+// S3ArtifactRepository stores artifacts as objects below the prefix of an `s3://bucket/prefix` URI.
+type S3ArtifactRepository struct {
+	client *client
+	prefix string
+}
+
+func NewS3ArtifactRepository(rootURI string, config Config) (*S3ArtifactRepository, error) {
+	parsedURI, err := url.Parse(rootURI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse artifact root %q: %w", rootURI, err)
+	}
+
+	if parsedURI.Scheme != "s3" || parsedURI.Host == "" {
+		return nil, fmt.Errorf("artifact root %q is not of the form s3://bucket/prefix", rootURI) //nolint:err113
+	}
+
+	client, err := newClient(parsedURI.Host, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &S3ArtifactRepository{
+		client: client,
+		prefix: strings.Trim(parsedURI.Path, "/"),
+	}, nil
+}
+
+// key validates the artifact path and returns the object key it is stored at.
+func (r S3ArtifactRepository) key(artifactPath string) (string, *contract.Error) {
+	cleaned, contractError := repository.ValidatePath(artifactPath)
+	if contractError != nil {
+		return "", contractError
+	}
+
+	return strings.Trim(path.Join(r.prefix, cleaned), "/"), nil
+}
+
+// relativePath converts an object key back to a path relative to the repository root.
+func (r S3ArtifactRepository) relativePath(key string) string {
+	if r.prefix == "" {
+		return strings.TrimSuffix(key, "/")
+	}
+
+	return strings.TrimSuffix(strings.TrimPrefix(key, r.prefix+"/"), "/")
+}
+
+// invalidRequestCodes are the errors caused by the parts a client reported for a multipart upload.
+var invalidRequestCodes = []string{"InvalidPart", "InvalidPartOrder", "EntityTooSmall"}
+
+func newObjectStoreError(message string, err error) *contract.Error {
+	var responseErr *responseError
+
+	switch {
+	case isNotFound(err):
+		return contract.NewErrorWith(protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, message, err)
+	case errors.As(err, &responseErr) && slices.Contains(invalidRequestCodes, responseErr.Code):
+		return contract.NewErrorWith(protos.ErrorCode_INVALID_PARAMETER_VALUE, message, err)
+	default:
+		return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, message, err)
+	}
+}
+
+func (r S3ArtifactRepository) DownloadArtifact(
+	ctx context.Context, artifactPath string,
+) (io.ReadCloser, int64, *contract.Error) {
+	key, contractError := r.key(artifactPath)
+	if contractError != nil {
+		return nil, 0, contractError
+	}
+
+	reader, size, err := r.client.getObject(ctx, key)
+	if err != nil {
+		return nil, 0, newObjectStoreError(fmt.Sprintf("failed to download artifact %q", artifactPath), err)
+	}
+
+	return reader, size, nil
+}
+
+// UploadArtifact buffers at most one part in memory. Content larger than a part is uploaded
+// with a multipart upload, which is aborted when any of the parts fails.
+func (r S3ArtifactRepository) UploadArtifact(
+	ctx context.Context, artifactPath string, reader io.Reader,
+) *contract.Error {
+	key, contractError := r.key(artifactPath)
+	if contractError != nil {
+		return contractError
+	}
+
+	if key == r.prefix {
+		return contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE, "Artifact path must point to a file")
+	}
+
+	buffer := make([]byte, uploadPartSize)
+
+	size, err := io.ReadFull(reader, buffer)
+	switch {
+	case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF):
+		err = r.client.putObject(ctx, key, buffer[:size])
+	case err == nil:
+		err = r.uploadInParts(ctx, key, buffer, reader)
+	}
+
+	if err != nil {
+		return newObjectStoreError(fmt.Sprintf("failed to upload artifact %q", artifactPath), err)
+	}
+
+	return nil
+}
+
+func (r S3ArtifactRepository) uploadInParts(ctx context.Context, key string, buffer []byte, reader io.Reader) error {
+	uploadID, err := r.client.createMultipartUpload(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	var parts []completedPart
+
+	err = func() error {
+		for partNumber, size := int64(1), len(buffer); size > 0; partNumber++ {
+			etag, err := r.client.uploadPart(ctx, key, uploadID, partNumber, buffer[:size])
+			if err != nil {
+				return err
+			}
+
+			parts = append(parts, completedPart{PartNumber: partNumber, ETag: etag})
+
+			size, err = io.ReadFull(reader, buffer)
+			if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+				return fmt.Errorf("failed to read part %d: %w", partNumber+1, err)
+			}
+		}
+
+		return r.client.completeMultipartUpload(ctx, key, uploadID, parts)
+	}()
+	if err != nil {
+		// the upload is aborted even when the request was cancelled, so that no parts are left behind.
+		if abortErr := r.client.abortMultipartUpload(context.WithoutCancel(ctx), key, uploadID); abortErr != nil {
+			return errors.Join(err, abortErr)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// ListArtifacts returns an empty list when path does not exist or is not a directory.
+func (r S3ArtifactRepository) ListArtifacts(
+	ctx context.Context, artifactPath string,
+) ([]*entities.FileInfo, *contract.Error) {
+	key, contractError := r.key(artifactPath)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	prefix := key
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	files := make([]*entities.FileInfo, 0)
+	continuationToken := ""
+
+	for {
+		result, err := r.client.listObjects(ctx, prefix, "/", continuationToken)
+		if err != nil {
+			return nil, newObjectStoreError(fmt.Sprintf("failed to list artifacts in %q", artifactPath), err)
+		}
+
+		for _, object := range result.Contents {
+			// placeholder objects created for empty directories.
+			if object.Key == prefix {
+				continue
+			}
+
+			files = append(files, &entities.FileInfo{Path: r.relativePath(object.Key), FileSize: object.Size})
+		}
+
+		for _, commonPrefix := range result.CommonPrefixes {
+			files = append(files, &entities.FileInfo{Path: r.relativePath(commonPrefix.Prefix), IsDir: true})
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+
+		continuationToken = result.NextContinuationToken
+	}
+
+	slices.SortFunc(files, func(a, b *entities.FileInfo) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return files, nil
+}
+
+// DeleteArtifact removes the object at path and every object below it.
+func (r S3ArtifactRepository) DeleteArtifact(ctx context.Context, artifactPath string) *contract.Error {
+	key, contractError := r.key(artifactPath)
+	if contractError != nil {
+		return contractError
+	}
+
+	if key == r.prefix {
+		return contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE, "Deleting the artifact root is not allowed")
+	}
+
+	keys := []string{key}
+	continuationToken := ""
+
+	for {
+		result, err := r.client.listObjects(ctx, key+"/", "", continuationToken)
+		if err != nil {
+			return newObjectStoreError(fmt.Sprintf("failed to list artifacts in %q", artifactPath), err)
+		}
+
+		for _, object := range result.Contents {
+			keys = append(keys, object.Key)
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+
+		continuationToken = result.NextContinuationToken
+	}
+
+	for _, key := range keys {
+		// deleting a missing object succeeds, so there is no need to check whether path is a file.
+		if err := r.client.deleteObject(ctx, key); err != nil && !isNotFound(err) {
+			return newObjectStoreError(fmt.Sprintf("failed to delete artifact %q", artifactPath), err)
+		}
+	}
+
+	return nil
+}
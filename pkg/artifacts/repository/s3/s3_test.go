@@ -0,0 +1,223 @@
+package s3_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/s3"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+func newRepository(t *testing.T) (*s3.S3ArtifactRepository, *fakeS3) {
+	t.Helper()
+
+	fake, server := newFakeS3(t)
+
+	repository, err := s3.NewS3ArtifactRepository("s3://"+fakeBucket+"/mlartifacts", s3.Config{
+		EndpointURL:     server.URL,
+		Region:          "us-east-1",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+	})
+	require.NoError(t, err)
+
+	return repository, fake
+}
+
+func TestNewS3ArtifactRepositoryInvalidURI(t *testing.T) {
+	t.Parallel()
+
+	for _, uri := range []string{"s3:///prefix", "gs://bucket/prefix", "/local/path"} {
+		_, err := s3.NewS3ArtifactRepository(uri, s3.Config{})
+		require.Error(t, err, uri)
+	}
+}
+
+func TestUploadDownloadAndList(t *testing.T) {
+	t.Parallel()
+
+	repository, fake := newRepository(t)
+	ctx := context.Background()
+
+	require.Nil(t, repository.UploadArtifact(ctx, "1/run/model/MLmodel", strings.NewReader("flavors: {}")))
+	require.Nil(t, repository.UploadArtifact(ctx, "1/run/model/data/weights.bin", strings.NewReader("0123456789")))
+	require.Nil(t, repository.UploadArtifact(ctx, "1/run/model/data/config.json", strings.NewReader("{}")))
+	require.Nil(t, repository.UploadArtifact(ctx, "1/run/model/requirements.txt", strings.NewReader("")))
+	require.Nil(t, repository.UploadArtifact(ctx, "1/run/model/conda.yaml", strings.NewReader("name: x")))
+
+	content, ok := fake.object("mlartifacts/1/run/model/MLmodel")
+	require.True(t, ok)
+	assert.Equal(t, "flavors: {}", string(content))
+
+	reader, size, contractError := repository.DownloadArtifact(ctx, "1/run/model/data/weights.bin")
+	require.Nil(t, contractError)
+
+	downloaded, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, "0123456789", string(downloaded))
+	assert.Equal(t, int64(10), size)
+
+	// the fake returns two entries per page.
+	files, contractError := repository.ListArtifacts(ctx, "1/run/model")
+	require.Nil(t, contractError)
+	assert.Equal(t, []*entities.FileInfo{
+		{Path: "1/run/model/MLmodel", FileSize: 11},
+		{Path: "1/run/model/conda.yaml", FileSize: 7},
+		{Path: "1/run/model/data", IsDir: true},
+		{Path: "1/run/model/requirements.txt", FileSize: 0},
+	}, files)
+
+	files, contractError = repository.ListArtifacts(ctx, "")
+	require.Nil(t, contractError)
+	assert.Equal(t, []*entities.FileInfo{{Path: "1", IsDir: true}}, files)
+
+	files, contractError = repository.ListArtifacts(ctx, "1/run/model/MLmodel")
+	require.Nil(t, contractError)
+	assert.Empty(t, files)
+}
+
+func TestUploadLargeArtifactInParts(t *testing.T) {
+	t.Parallel()
+
+	repository, fake := newRepository(t)
+	ctx := context.Background()
+
+	content := bytes.Repeat([]byte("0123456789abcdef"), 1024*1024+1)
+
+	require.Nil(t, repository.UploadArtifact(ctx, "model.bin", bytes.NewReader(content)))
+
+	uploaded, ok := fake.object("mlartifacts/model.bin")
+	require.True(t, ok)
+	assert.Equal(t, content, uploaded)
+	assert.Zero(t, fake.pendingUploads())
+}
+
+func TestDownloadMissingArtifact(t *testing.T) {
+	t.Parallel()
+
+	repository, _ := newRepository(t)
+
+	_, _, contractError := repository.DownloadArtifact(context.Background(), "missing.txt")
+	require.NotNil(t, contractError)
+	assert.Equal(t, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, protos.ErrorCode(contractError.Code))
+}
+
+func TestDeleteArtifact(t *testing.T) {
+	t.Parallel()
+
+	repository, fake := newRepository(t)
+	ctx := context.Background()
+
+	for _, artifactPath := range []string{"run/a.txt", "run/model/b.txt", "run/model/c.txt", "run/model/d/e.txt"} {
+		require.Nil(t, repository.UploadArtifact(ctx, artifactPath, strings.NewReader("x")))
+	}
+
+	require.Nil(t, repository.UploadArtifact(ctx, "run/models.txt", strings.NewReader("x")))
+
+	require.Nil(t, repository.DeleteArtifact(ctx, "run/model"))
+	require.Nil(t, repository.DeleteArtifact(ctx, "run/a.txt"))
+	require.Nil(t, repository.DeleteArtifact(ctx, "run/missing"))
+
+	files, contractError := repository.ListArtifacts(ctx, "run")
+	require.Nil(t, contractError)
+	assert.Equal(t, []*entities.FileInfo{{Path: "run/models.txt", FileSize: 1}}, files)
+
+	_, ok := fake.object("mlartifacts/run/model/d/e.txt")
+	assert.False(t, ok)
+
+	contractError = repository.DeleteArtifact(ctx, "")
+	require.NotNil(t, contractError)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractError.Code))
+}
+
+func TestPathTraversalIsRejected(t *testing.T) {
+	t.Parallel()
+
+	repository, _ := newRepository(t)
+	ctx := context.Background()
+
+	for _, artifactPath := range []string{"../other/file.txt", "run/%2e%2e/%2e%2e/file.txt", "/etc/passwd"} {
+		contractError := repository.UploadArtifact(ctx, artifactPath, strings.NewReader("x"))
+		require.NotNil(t, contractError, artifactPath)
+		assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractError.Code), artifactPath)
+
+		_, contractError = repository.ListArtifacts(ctx, artifactPath)
+		require.NotNil(t, contractError, artifactPath)
+	}
+}
+
+func uploadToPresignedURL(t *testing.T, url, content string) string {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, url, strings.NewReader(content))
+	require.NoError(t, err)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	return resp.Header.Get("ETag")
+}
+
+func TestMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	repository, fake := newRepository(t)
+	ctx := context.Background()
+
+	upload, contractError := repository.CreateMultipartUpload(ctx, "run/checkpoint.bin", 2)
+	require.Nil(t, contractError)
+	require.Len(t, upload.Credentials, 2)
+	assert.Contains(t, upload.Credentials[0].URL, "X-Amz-Signature=")
+
+	etag1 := uploadToPresignedURL(t, upload.Credentials[0].URL, "hello ")
+	etag2 := uploadToPresignedURL(t, upload.Credentials[1].URL, "world")
+
+	contractError = repository.CompleteMultipartUpload(ctx, "run/checkpoint.bin", upload.UploadID,
+		[]*entities.MultipartUploadPart{{PartNumber: 1, ETag: etag2}, {PartNumber: 2, ETag: etag2}},
+	)
+	require.NotNil(t, contractError)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractError.Code))
+
+	contractError = repository.CompleteMultipartUpload(ctx, "run/checkpoint.bin", upload.UploadID,
+		[]*entities.MultipartUploadPart{{PartNumber: 1, ETag: etag1}, {PartNumber: 2, ETag: strings.Trim(etag2, `"`)}},
+	)
+	require.Nil(t, contractError)
+
+	content, ok := fake.object("mlartifacts/run/checkpoint.bin")
+	require.True(t, ok)
+	assert.Equal(t, "hello world", string(content))
+}
+
+func TestAbortMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	repository, fake := newRepository(t)
+	ctx := context.Background()
+
+	upload, contractError := repository.CreateMultipartUpload(ctx, "file.bin", 1)
+	require.Nil(t, contractError)
+
+	require.Nil(t, repository.AbortMultipartUpload(ctx, "file.bin", upload.UploadID))
+	assert.Zero(t, fake.pendingUploads())
+
+	contractError = repository.AbortMultipartUpload(ctx, "file.bin", upload.UploadID)
+	require.NotNil(t, contractError)
+	assert.Equal(t, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, protos.ErrorCode(contractError.Code))
+
+	_, contractError = repository.UploadPart(
+		ctx, upload.UploadID, 1, strings.NewReader("x"), entities.MultipartUploadPartChecksums{},
+	)
+	require.NotNil(t, contractError)
+	assert.Equal(t, protos.ErrorCode_NOT_IMPLEMENTED, protos.ErrorCode(contractError.Code))
+}
@@ -0,0 +1,207 @@
+package s3
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	signingService   = "s3"
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+	amzDateFormat    = "20060102T150405Z"
+	shortDateFormat  = "20060102"
+)
+
+// signer implements AWS Signature Version 4 for S3 requests and presigned URLs.
+// Requests are sent anonymously when no access key is configured.
+type signer struct {
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+	now             func() time.Time
+}
+
+func hashSHA256(data []byte) string {
+	hash := sha256.Sum256(data)
+
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
+
+// uriEncode encodes everything but the unreserved characters, as required by Signature Version 4.
+func uriEncode(value string, encodeSlash bool) string {
+	var builder strings.Builder
+
+	for _, char := range []byte(value) {
+		switch {
+		case 'A' <= char && char <= 'Z', 'a' <= char && char <= 'z', '0' <= char && char <= '9',
+			char == '-', char == '_', char == '.', char == '~':
+			builder.WriteByte(char)
+		case char == '/' && !encodeSlash:
+			builder.WriteByte(char)
+		default:
+			fmt.Fprintf(&builder, "%%%02X", char)
+		}
+	}
+
+	return builder.String()
+}
+
+// encodeQuery returns the canonical query string, which is also used as the query of the request itself.
+func encodeQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	parts := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		values := slices.Clone(query[key])
+		slices.Sort(values)
+
+		for _, value := range values {
+			parts = append(parts, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+
+	return strings.Join(parts, "&")
+}
+
+func isSignedHeader(name string) bool {
+	return strings.HasPrefix(name, "x-amz-") || name == "content-type" || name == "content-md5" || name == "range"
+}
+
+func canonicalHeaders(req *http.Request) (string, string) {
+	headers := map[string]string{"host": req.URL.Host}
+
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if isSignedHeader(name) {
+			headers[name] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	var builder strings.Builder
+	for _, name := range names {
+		builder.WriteString(name + ":" + headers[name] + "\n")
+	}
+
+	return builder.String(), strings.Join(names, ";")
+}
+
+func (s signer) scope(now time.Time) string {
+	return strings.Join([]string{now.Format(shortDateFormat), s.region, signingService, "aws4_request"}, "/")
+}
+
+func (s signer) signature(now time.Time, canonicalRequest string) string {
+	stringToSign := strings.Join([]string{
+		signingAlgorithm,
+		now.Format(amzDateFormat),
+		s.scope(now),
+		hashSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretAccessKey), now.Format(shortDateFormat))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, signingService)
+	key = hmacSHA256(key, "aws4_request")
+
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// sign adds the authorization headers to the request. payloadHash is the hex encoded SHA-256
+// of the body, or unsignedPayload for streamed bodies.
+func (s signer) sign(req *http.Request, payloadHash string) {
+	if s.accessKeyID == "" {
+		return
+	}
+
+	now := s.now().UTC()
+
+	req.Header.Set("X-Amz-Date", now.Format(amzDateFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	if s.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.sessionToken)
+	}
+
+	headers, signedHeaders := canonicalHeaders(req)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		headers,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s,SignedHeaders=%s,Signature=%s",
+		signingAlgorithm,
+		s.accessKeyID,
+		s.scope(now),
+		signedHeaders,
+		s.signature(now, canonicalRequest),
+	))
+}
+
+// presign returns a URL which can be used without credentials until it expires.
+func (s signer) presign(method string, objectURL *url.URL, expires time.Duration) string {
+	presigned := *objectURL
+	if s.accessKeyID == "" {
+		return presigned.String()
+	}
+
+	now := s.now().UTC()
+
+	query := presigned.Query()
+	query.Set("X-Amz-Algorithm", signingAlgorithm)
+	query.Set("X-Amz-Credential", s.accessKeyID+"/"+s.scope(now))
+	query.Set("X-Amz-Date", now.Format(amzDateFormat))
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expires.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	if s.sessionToken != "" {
+		query.Set("X-Amz-Security-Token", s.sessionToken)
+	}
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		presigned.EscapedPath(),
+		encodeQuery(query),
+		"host:" + presigned.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+
+	query.Set("X-Amz-Signature", s.signature(now, canonicalRequest))
+	presigned.RawQuery = encodeQuery(query)
+
+	return presigned.String()
+}
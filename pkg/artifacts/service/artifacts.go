@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"io"
+	"path"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos/artifacts"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+func (as ArtifactsService) ListArtifacts(
+	ctx context.Context, input *artifacts.ListArtifacts,
+) (*artifacts.ListArtifacts_Response, *contract.Error) {
+	files, err := as.Repository.ListArtifacts(ctx, input.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	response := artifacts.ListArtifacts_Response{
+		Files: make([]*artifacts.FileInfo, 0, len(files)),
+	}
+
+	// paths are reported relative to the listed directory.
+	for _, file := range files {
+		fileInfo := &artifacts.FileInfo{
+			Path:  utils.PtrTo(path.Base(file.Path)),
+			IsDir: utils.PtrTo(file.IsDir),
+		}
+
+		if !file.IsDir {
+			fileInfo.FileSize = utils.PtrTo(file.FileSize)
+		}
+
+		response.Files = append(response.Files, fileInfo)
+	}
+
+	return &response, nil
+}
+
+func (as ArtifactsService) DownloadArtifact(
+	ctx context.Context, artifactPath string,
+) (io.ReadCloser, int64, *contract.Error) {
+	return as.Repository.DownloadArtifact(ctx, artifactPath)
+}
+
+func (as ArtifactsService) UploadArtifact(
+	ctx context.Context, artifactPath string, reader io.Reader,
+) *contract.Error {
+	return as.Repository.UploadArtifact(ctx, artifactPath, reader)
+}
+
+func (as ArtifactsService) DeleteArtifact(ctx context.Context, artifactPath string) *contract.Error {
+	return as.Repository.DeleteArtifact(ctx, artifactPath)
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos/artifacts"
+)
+
+func (as ArtifactsService) multipartUploadRepository() (repository.MultipartUploadRepository, *contract.Error) {
+	multipartUploadRepository, ok := as.Repository.(repository.MultipartUploadRepository)
+	if !ok {
+		return nil, contract.NewError(
+			protos.ErrorCode_NOT_IMPLEMENTED,
+			"The artifact repository does not support multipart upload",
+		)
+	}
+
+	return multipartUploadRepository, nil
+}
+
+// multipartUploadDestination returns the artifact the upload is written to. Like the Python
+// server, only the base name of the uploaded file is kept.
+func multipartUploadDestination(artifactPath, filePath string) string {
+	return path.Join(artifactPath, path.Base(filePath))
+}
+
+// CreateMultipartUpload starts an upload below artifactPath. When the repository does not
+// hand out its own URLs, parts are uploaded to `<partUploadURL>/<upload_id>/<part_number>`.
+func (as ArtifactsService) CreateMultipartUpload(
+	ctx context.Context, artifactPath string, input *artifacts.CreateMultipartUpload, partUploadURL string,
+) (*artifacts.CreateMultipartUpload_Response, *contract.Error) {
+	multipartUploadRepository, err := as.multipartUploadRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := multipartUploadRepository.CreateMultipartUpload(
+		ctx, multipartUploadDestination(artifactPath, input.GetPath()), input.GetNumParts(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := upload.Credentials
+	if len(credentials) == 0 {
+		credentials = make([]*entities.MultipartUploadCredential, 0, input.GetNumParts())
+		for partNumber := int64(1); partNumber <= input.GetNumParts(); partNumber++ {
+			credentials = append(credentials, &entities.MultipartUploadCredential{
+				URL:        fmt.Sprintf("%s/%s/%d", partUploadURL, upload.UploadID, partNumber),
+				PartNumber: partNumber,
+			})
+		}
+	}
+
+	response := artifacts.CreateMultipartUpload_Response{
+		UploadId:    &upload.UploadID,
+		Credentials: make([]*artifacts.MultipartUploadCredential, 0, len(credentials)),
+	}
+
+	for _, credential := range credentials {
+		response.Credentials = append(response.Credentials, credential.ToProto())
+	}
+
+	return &response, nil
+}
+
+func (as ArtifactsService) UploadMultipartUploadPart(
+	ctx context.Context, uploadID string, partNumber int64, reader io.Reader,
+	checksums entities.MultipartUploadPartChecksums,
+) (string, *contract.Error) {
+	multipartUploadRepository, err := as.multipartUploadRepository()
+	if err != nil {
+		return "", err
+	}
+
+	return multipartUploadRepository.UploadPart(ctx, uploadID, partNumber, reader, checksums)
+}
+
+func (as ArtifactsService) CompleteMultipartUpload(
+	ctx context.Context, artifactPath string, input *artifacts.CompleteMultipartUpload,
+) (*artifacts.CompleteMultipartUpload_Response, *contract.Error) {
+	multipartUploadRepository, err := as.multipartUploadRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := multipartUploadRepository.CompleteMultipartUpload(
+		ctx,
+		multipartUploadDestination(artifactPath, input.GetPath()),
+		input.GetUploadId(),
+		entities.MultipartUploadPartsFromProto(input.GetParts()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &artifacts.CompleteMultipartUpload_Response{}, nil
+}
+
+func (as ArtifactsService) AbortMultipartUpload(
+	ctx context.Context, artifactPath string, input *artifacts.AbortMultipartUpload,
+) (*artifacts.AbortMultipartUpload_Response, *contract.Error) {
+	multipartUploadRepository, err := as.multipartUploadRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := multipartUploadRepository.AbortMultipartUpload(
+		ctx, multipartUploadDestination(artifactPath, input.GetPath()), input.GetUploadId(),
+	); err != nil {
+		return nil, err
+	}
+
+	return &artifacts.AbortMultipartUpload_Response{}, nil
+}
@@ -2,17 +2,39 @@ package service
 
 import (
 	"context"
+	"errors"
 
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository"
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/resolver"
 	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
 
 type ArtifactsService struct {
 	config *config.Config
+	// Repository is nil when the artifacts are not served, or when the artifacts destination
+	// cannot be served by the Go server, in which case artifact requests are left to the Python server.
+	Repository repository.ArtifactRepository
 }
 
-func NewArtifactsService(_ context.Context, config *config.Config) (*ArtifactsService, error) {
+func NewArtifactsService(ctx context.Context, config *config.Config) (*ArtifactsService, error) {
+	// like the Python server, which disables the artifact endpoints with --no-serve-artifacts.
+	if !config.ServeArtifacts {
+		return &ArtifactsService{config: config}, nil
+	}
+
+	repository, err := resolver.NewArtifactRepository(config.ArtifactsDestination, "")
+	if err != nil {
+		if !errors.Is(err, resolver.ErrUnsupportedURI) {
+			return nil, err //nolint:wrapcheck
+		}
+
+		utils.GetLoggerFromContext(ctx).Warnf("Artifacts will be served by the Python server: %v", err)
+	}
+
 	return &ArtifactsService{
-		config: config,
+		config:     config,
+		Repository: repository,
 	}, nil
 }
 
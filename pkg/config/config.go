@@ -38,19 +38,78 @@ func (d *Duration) UnmarshalJSON(b []byte) error {
 	}
 }
 
+// DatabaseConfig configures the connection pools of the SQL stores and the retries of their
+// transactions. Zero pool settings keep the defaults of database/sql.
+type DatabaseConfig struct {
+	MaxOpenConns    int      `json:"max_open_conns"`
+	MaxIdleConns    int      `json:"max_idle_conns"`
+	ConnMaxLifetime Duration `json:"conn_max_lifetime"`
+	ConnMaxIdleTime Duration `json:"conn_max_idle_time"`
+	// StatementTimeout makes PostgreSQL abort the statements, and MySQL the SELECT statements,
+	// running longer than it. The schema upgrades are not limited.
+	StatementTimeout Duration `json:"statement_timeout"`
+	// MaxRetries is the number of times a transaction failing on a serialization failure, a deadlock
+	// or a busy SQLite database is retried, negative to never retry.
+	MaxRetries int `json:"max_retries"`
+	// RetryBackoff is the delay before the first retry, doubled for every next one up to MaxRetryBackoff.
+	RetryBackoff    Duration `json:"retry_backoff"`
+	MaxRetryBackoff Duration `json:"max_retry_backoff"`
+	// ReplicaHealthCheckInterval is how often the read replicas are pinged, the reads of a replica
+	// failing to answer going to the primary until it answers again.
+	ReplicaHealthCheckInterval Duration `json:"replica_health_check_interval"`
+}
+
+// MetricBufferConfig configures the buffer coalescing the metrics logged one by one to a run into
+// batches, which is disabled unless Window is set.
+type MetricBufferConfig struct {
+	// Window is how long the first metric of a batch waits for the next ones before it is written.
+	Window Duration `json:"window"`
+	// MaxSize is the number of metrics of a run written as soon as they are buffered.
+	MaxSize int `json:"max_size"`
+	// FireAndForget acknowledges the metrics as soon as they are buffered, instead of once they are
+	// written, at the cost of losing them if they fail to be written or the server crashes.
+	FireAndForget bool `json:"fire_and_forget"`
+}
+
+// RunHeartbeatConfig configures the reaper failing the runs which stopped sending heartbeats, the
+// runs which never sent one being left alone.
+type RunHeartbeatConfig struct {
+	// Timeout is how long a run may go without a heartbeat before it is failed, unless its
+	// experiment sets another one with the mlflow.runHeartbeatTimeout tag.
+	Timeout Duration `json:"timeout"`
+	// ReapInterval is how often the stale runs are looked for.
+	ReapInterval Duration `json:"reap_interval"`
+}
+
 type Config struct {
-	Address               string                 `json:"address"`
+	// AdminToken is the bearer token of the admin endpoints, which are disabled when it is empty.
+	AdminToken           string         `json:"admin_token"`
+	Address              string         `json:"address"`
+	ArtifactsDestination string         `json:"artifacts_destination"`
+	Database             DatabaseConfig `json:"database"`
+	// DatabaseUpgrade creates, or upgrades, the schema of the databases at launch. Only the schemas
+	// from revision 0584bdc529eb on can be upgraded, older ones need `mlflow db upgrade`.
+	DatabaseUpgrade       bool                   `json:"database_upgrade"`
 	DefaultArtifactRoot   string                 `json:"default_artifact_root"`
+	IdempotencyKeyTTL     Duration               `json:"idempotency_key_ttl"`
 	LogLevel              string                 `json:"log_level"`
+	MetricBuffer          MetricBufferConfig     `json:"metric_buffer"`
 	ModelRegistryStoreURI string                 `json:"model_registry_store_uri"`
 	PythonEnv             []string               `json:"python_env"`
 	PythonAddress         string                 `json:"python_address"`
 	PythonCommand         []string               `json:"python_command"`
 	PythonTestsENV        map[string]interface{} `json:"python_tests_env"`
-	ShutdownTimeout       Duration               `json:"shutdown_timeout"`
-	StaticFolder          string                 `json:"static_folder"`
-	TrackingStoreURI      string                 `json:"tracking_store_uri"`
-	Version               string                 `json:"version"`
+	RunHeartbeat          RunHeartbeatConfig     `json:"run_heartbeat"`
+	// ServeArtifacts serves the mlflow-artifacts endpoints, like `mlflow server --serve-artifacts`.
+	ServeArtifacts   bool     `json:"serve_artifacts"`
+	ShutdownTimeout  Duration `json:"shutdown_timeout"`
+	StaticFolder     string   `json:"static_folder"`
+	TrackingStoreURI string   `json:"tracking_store_uri"`
+	// TrackingStoreReadReplicaURIs are read replicas of the tracking store database, which serve
+	// the searches and gets tolerating replication lag, including those of the model registry when
+	// it lives in the same database.
+	TrackingStoreReadReplicaURIs []string `json:"tracking_store_read_replica_uris"`
+	Version                      string   `json:"version"`
 }
 
 func NewConfigFromBytes(cfgBytes []byte) (*Config, error) {
@@ -77,10 +136,22 @@ func (c *Config) applyDefaults() {
 		c.Address = "localhost:5000"
 	}
 
+	if c.ArtifactsDestination == "" {
+		c.ArtifactsDestination = "./mlartifacts"
+	}
+
+	c.Database.applyDefaults()
+	c.MetricBuffer.applyDefaults()
+	c.RunHeartbeat.applyDefaults()
+
 	if c.DefaultArtifactRoot == "" {
 		c.DefaultArtifactRoot = "mlflow-artifacts:/"
 	}
 
+	if c.IdempotencyKeyTTL.Duration == 0 {
+		c.IdempotencyKeyTTL.Duration = 24 * time.Hour //nolint:mnd
+	}
+
 	if c.LogLevel == "" {
 		c.LogLevel = "INFO"
 	}
@@ -105,3 +176,39 @@ func (c *Config) applyDefaults() {
 		c.Version = "dev"
 	}
 }
+
+//nolint:mnd
+func (c *DatabaseConfig) applyDefaults() {
+	if c.MaxRetries == 0 {
+		c.MaxRetries = 3
+	}
+
+	if c.RetryBackoff.Duration == 0 {
+		c.RetryBackoff.Duration = 50 * time.Millisecond
+	}
+
+	if c.MaxRetryBackoff.Duration == 0 {
+		c.MaxRetryBackoff.Duration = time.Second
+	}
+
+	if c.ReplicaHealthCheckInterval.Duration == 0 {
+		c.ReplicaHealthCheckInterval.Duration = 10 * time.Second
+	}
+}
+
+func (c *MetricBufferConfig) applyDefaults() {
+	if c.MaxSize == 0 {
+		// the most metrics a LogBatch request can hold.
+		c.MaxSize = 1000 //nolint:mnd
+	}
+}
+
+func (c *RunHeartbeatConfig) applyDefaults() {
+	if c.Timeout.Duration == 0 {
+		c.Timeout.Duration = 10 * time.Minute //nolint:mnd
+	}
+
+	if c.ReapInterval.Duration == 0 {
+		c.ReapInterval.Duration = time.Minute
+	}
+}
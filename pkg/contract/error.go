@@ -2,11 +2,16 @@ package contract
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
 )
 
+// ErrFallThrough is wrapped by the errors of the requests the Go server cannot serve, which are
+// then forwarded to the Python server if there is one.
+var ErrFallThrough = errors.New("request must be served by the Python server")
+
 type ErrorCode protos.ErrorCode
 
 func (e ErrorCode) String() string {
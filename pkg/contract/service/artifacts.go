@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos/artifacts"
+)
+
+// ArtifactsContentService transfers artifact content. The request and response bodies of these
+// endpoints are raw file content rather than JSON messages, or their artifact path is a nested URL path,
+// so they are not part of the generated interface.
+type ArtifactsContentService interface {
+	DownloadArtifact(ctx context.Context, path string) (io.ReadCloser, int64, *contract.Error)
+	UploadArtifact(ctx context.Context, path string, reader io.Reader) *contract.Error
+	DeleteArtifact(ctx context.Context, path string) *contract.Error
+	CreateMultipartUpload(
+		ctx context.Context, path string, input *artifacts.CreateMultipartUpload, partUploadURL string,
+	) (*artifacts.CreateMultipartUpload_Response, *contract.Error)
+	UploadMultipartUploadPart(
+		ctx context.Context, uploadID string, partNumber int64, reader io.Reader,
+		checksums entities.MultipartUploadPartChecksums,
+	) (string, *contract.Error)
+	CompleteMultipartUpload(
+		ctx context.Context, path string, input *artifacts.CompleteMultipartUpload,
+	) (*artifacts.CompleteMultipartUpload_Response, *contract.Error)
+	AbortMultipartUpload(
+		ctx context.Context, path string, input *artifacts.AbortMultipartUpload,
+	) (*artifacts.AbortMultipartUpload_Response, *contract.Error)
+}
@@ -15,11 +15,14 @@ type ModelRegistryService interface {
 	UpdateRegisteredModel(ctx context.Context, input *protos.UpdateRegisteredModel) (*protos.UpdateRegisteredModel_Response, *contract.Error)
 	DeleteRegisteredModel(ctx context.Context, input *protos.DeleteRegisteredModel) (*protos.DeleteRegisteredModel_Response, *contract.Error)
 	GetRegisteredModel(ctx context.Context, input *protos.GetRegisteredModel) (*protos.GetRegisteredModel_Response, *contract.Error)
+	SearchRegisteredModels(ctx context.Context, input *protos.SearchRegisteredModels) (*protos.SearchRegisteredModels_Response, *contract.Error)
 	GetLatestVersions(ctx context.Context, input *protos.GetLatestVersions) (*protos.GetLatestVersions_Response, *contract.Error)
+	CreateModelVersion(ctx context.Context, input *protos.CreateModelVersion) (*protos.CreateModelVersion_Response, *contract.Error)
 	UpdateModelVersion(ctx context.Context, input *protos.UpdateModelVersion) (*protos.UpdateModelVersion_Response, *contract.Error)
 	TransitionModelVersionStage(ctx context.Context, input *protos.TransitionModelVersionStage) (*protos.TransitionModelVersionStage_Response, *contract.Error)
 	DeleteModelVersion(ctx context.Context, input *protos.DeleteModelVersion) (*protos.DeleteModelVersion_Response, *contract.Error)
 	GetModelVersion(ctx context.Context, input *protos.GetModelVersion) (*protos.GetModelVersion_Response, *contract.Error)
+	SearchModelVersions(ctx context.Context, input *protos.SearchModelVersions) (*protos.SearchModelVersions_Response, *contract.Error)
 	GetModelVersionDownloadUri(ctx context.Context, input *protos.GetModelVersionDownloadUri) (*protos.GetModelVersionDownloadUri_Response, *contract.Error)
 	SetRegisteredModelTag(ctx context.Context, input *protos.SetRegisteredModelTag) (*protos.SetRegisteredModelTag_Response, *contract.Error)
 	SetModelVersionTag(ctx context.Context, input *protos.SetModelVersionTag) (*protos.SetModelVersionTag_Response, *contract.Error)
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// SearchExplainService returns the SQL query of the searches instead of running them. Its endpoints
+// are meant for the administrators tuning the database, so they are not part of the MLflow API.
+type SearchExplainService interface {
+	ExplainSearchRuns(ctx context.Context, input *protos.SearchRuns) (*entities.SearchExplanation, *contract.Error)
+	ExplainSearchExperiments(
+		ctx context.Context, input *protos.SearchExperiments,
+	) (*entities.SearchExplanation, *contract.Error)
+	ExplainSearchTraces(ctx context.Context, input *protos.SearchTraces) (*entities.SearchExplanation, *contract.Error)
+}
+
+// IdempotencyService records the responses to the requests made with an idempotency key, for the
+// requests retried with the same key to be answered without being processed again.
+type IdempotencyService interface {
+	// ClaimIdempotencyKey returns the response recorded for key, nil when the request of requestHash
+	// is the first one with it and must be processed.
+	ClaimIdempotencyKey(ctx context.Context, key, requestHash string) (*entities.IdempotentResponse, *contract.Error)
+	SaveIdempotentResponse(ctx context.Context, key string, status int, body []byte) *contract.Error
+	ReleaseIdempotencyKey(ctx context.Context, key string) *contract.Error
+}
+
+// RunHeartbeatService records the heartbeats the runs send while their process is alive, which are
+// not part of the MLflow API.
+type RunHeartbeatService interface {
+	RecordRunHeartbeat(ctx context.Context, runID string) *contract.Error
+}
@@ -0,0 +1,25 @@
+package entities
+
+import "github.com/mlflow/mlflow-go-backend/pkg/protos"
+
+// FileInfo describes a file or directory stored in an artifact repository.
+// Path is relative to the root of the repository and always uses forward slashes.
+type FileInfo struct {
+	Path     string
+	IsDir    bool
+	FileSize int64
+}
+
+// ToProto leaves the size of directories unset.
+func (f FileInfo) ToProto() *protos.FileInfo {
+	fileInfo := protos.FileInfo{
+		Path:  &f.Path,
+		IsDir: &f.IsDir,
+	}
+
+	if !f.IsDir {
+		fileInfo.FileSize = &f.FileSize
+	}
+
+	return &fileInfo
+}
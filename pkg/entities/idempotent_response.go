@@ -0,0 +1,11 @@
+package entities
+
+// IdempotentResponse is the response recorded for an idempotency key, which is replayed to the
+// requests retried with the same key.
+type IdempotentResponse struct {
+	// RequestHash identifies the request which claimed the key.
+	RequestHash string
+	// Status is the HTTP status of the response, 0 while the request is still being processed.
+	Status int
+	Body   []byte
+}
@@ -0,0 +1,114 @@
+package entities
+
+import (
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+type LoggedModel struct {
+	ModelID                string
+	ExperimentID           string
+	Name                   string
+	ArtifactURI            string
+	CreationTimestampMS    int64
+	LastUpdatedTimestampMS int64
+	Status                 string
+	ModelType              *string
+	SourceRunID            *string
+	StatusMessage          *string
+	Tags                   []*LoggedModelTag
+	Params                 []*LoggedModelParam
+	Metrics                []*MetricWithRunID
+}
+
+func (m LoggedModel) ToProto() *protos.LoggedModel {
+	info := protos.LoggedModelInfo{
+		ModelId:                &m.ModelID,
+		ExperimentId:           &m.ExperimentID,
+		Name:                   &m.Name,
+		CreationTimestampMs:    &m.CreationTimestampMS,
+		LastUpdatedTimestampMs: &m.LastUpdatedTimestampMS,
+		ArtifactUri:            &m.ArtifactURI,
+		Status:                 utils.PtrTo(protos.LoggedModelStatus(protos.LoggedModelStatus_value[m.Status])),
+		ModelType:              m.ModelType,
+		SourceRunId:            m.SourceRunID,
+		StatusMessage:          m.StatusMessage,
+		Tags:                   make([]*protos.LoggedModelTag, 0, len(m.Tags)),
+	}
+
+	for _, tag := range m.Tags {
+		info.Tags = append(info.Tags, tag.ToProto())
+	}
+
+	data := protos.LoggedModelData{
+		Params:  make([]*protos.LoggedModelParameter, 0, len(m.Params)),
+		Metrics: make([]*protos.Metric, 0, len(m.Metrics)),
+	}
+
+	for _, param := range m.Params {
+		data.Params = append(data.Params, param.ToProto())
+	}
+
+	for _, metric := range m.Metrics {
+		protoMetric := metric.Metric.ToProto()
+		protoMetric.RunId = &metric.RunID
+		protoMetric.ModelId = &m.ModelID
+
+		if metric.DatasetName != "" {
+			protoMetric.DatasetName = &metric.DatasetName
+			protoMetric.DatasetDigest = &metric.DatasetDigest
+		}
+
+		data.Metrics = append(data.Metrics, protoMetric)
+	}
+
+	return &protos.LoggedModel{
+		Info: &info,
+		Data: &data,
+	}
+}
+
+// LoggedModelDataset scopes metric filters and ordering of SearchLoggedModels to a dataset.
+type LoggedModelDataset struct {
+	Name   string
+	Digest string
+}
+
+func LoggedModelDatasetsFromProto(protoDatasets []*protos.SearchLoggedModels_Dataset) []*LoggedModelDataset {
+	datasets := make([]*LoggedModelDataset, 0, len(protoDatasets))
+	for _, dataset := range protoDatasets {
+		datasets = append(datasets, &LoggedModelDataset{
+			Name:   dataset.GetDatasetName(),
+			Digest: dataset.GetDatasetDigest(),
+		})
+	}
+
+	return datasets
+}
+
+type LoggedModelOrderBy struct {
+	FieldName string
+	Ascending bool
+	Dataset   *LoggedModelDataset
+}
+
+func LoggedModelOrderByFromProto(protoOrderBy []*protos.SearchLoggedModels_OrderBy) []*LoggedModelOrderBy {
+	orderBy := make([]*LoggedModelOrderBy, 0, len(protoOrderBy))
+	for _, clause := range protoOrderBy {
+		entity := LoggedModelOrderBy{
+			FieldName: clause.GetFieldName(),
+			Ascending: clause.GetAscending(),
+		}
+
+		if clause.DatasetName != nil || clause.DatasetDigest != nil {
+			entity.Dataset = &LoggedModelDataset{
+				Name:   clause.GetDatasetName(),
+				Digest: clause.GetDatasetDigest(),
+			}
+		}
+
+		orderBy = append(orderBy, &entity)
+	}
+
+	return orderBy
+}
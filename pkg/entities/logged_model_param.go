@@ -0,0 +1,32 @@
+//nolint:dupl
+package entities
+
+import "github.com/mlflow/mlflow-go-backend/pkg/protos"
+
+type LoggedModelParam struct {
+	Key   string
+	Value string
+}
+
+func (p LoggedModelParam) ToProto() *protos.LoggedModelParameter {
+	return &protos.LoggedModelParameter{
+		Key:   &p.Key,
+		Value: &p.Value,
+	}
+}
+
+func NewLoggedModelParamFromProto(proto *protos.LoggedModelParameter) *LoggedModelParam {
+	return &LoggedModelParam{
+		Key:   proto.GetKey(),
+		Value: proto.GetValue(),
+	}
+}
+
+func LoggedModelParamsFromProto(protoParams []*protos.LoggedModelParameter) []*LoggedModelParam {
+	entityParams := make([]*LoggedModelParam, 0, len(protoParams))
+	for _, param := range protoParams {
+		entityParams = append(entityParams, NewLoggedModelParamFromProto(param))
+	}
+
+	return entityParams
+}
@@ -0,0 +1,32 @@
+//nolint:dupl
+package entities
+
+import "github.com/mlflow/mlflow-go-backend/pkg/protos"
+
+type LoggedModelTag struct {
+	Key   string
+	Value string
+}
+
+func (t LoggedModelTag) ToProto() *protos.LoggedModelTag {
+	return &protos.LoggedModelTag{
+		Key:   &t.Key,
+		Value: &t.Value,
+	}
+}
+
+func NewLoggedModelTagFromProto(proto *protos.LoggedModelTag) *LoggedModelTag {
+	return &LoggedModelTag{
+		Key:   proto.GetKey(),
+		Value: proto.GetValue(),
+	}
+}
+
+func LoggedModelTagsFromProto(protoTags []*protos.LoggedModelTag) []*LoggedModelTag {
+	entityTags := make([]*LoggedModelTag, 0, len(protoTags))
+	for _, tag := range protoTags {
+		entityTags = append(entityTags, NewLoggedModelTagFromProto(tag))
+	}
+
+	return entityTags
+}
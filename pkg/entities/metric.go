@@ -59,3 +59,20 @@ func MetricFromLogMetricProtoInput(input *protos.LogMetric) *Metric {
 		DatasetDigest: input.GetDatasetDigest(),
 	}
 }
+
+type MetricWithRunID struct {
+	Metric
+	RunID string
+}
+
+func (m MetricWithRunID) ToProto() *protos.MetricWithRunId {
+	metric := m.Metric.ToProto()
+
+	return &protos.MetricWithRunId{
+		Key:       metric.Key,
+		Value:     metric.Value,
+		Timestamp: metric.Timestamp,
+		Step:      metric.Step,
+		RunId:     &m.RunID,
+	}
+}
@@ -25,6 +25,15 @@ type ModelVersion struct {
 	Aliases         []*RegisteredModelAlias
 }
 
+// StorageLocationOrSource returns where the artifacts of the model version are stored.
+func (mv ModelVersion) StorageLocationOrSource() string {
+	if mv.StorageLocation != "" {
+		return mv.StorageLocation
+	}
+
+	return mv.Source
+}
+
 func (mv ModelVersion) ToProto() *protos.ModelVersion {
 	modelVersion := protos.ModelVersion{
 		Name:                 utils.PtrTo(mv.Name),
@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"github.com/mlflow/mlflow-go-backend/pkg/protos/artifacts"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+type MultipartUploadCredential struct {
+	URL        string
+	PartNumber int64
+	Headers    map[string]string
+}
+
+func (c MultipartUploadCredential) ToProto() *artifacts.MultipartUploadCredential {
+	return &artifacts.MultipartUploadCredential{
+		Url:        utils.PtrTo(c.URL),
+		PartNumber: utils.PtrTo(c.PartNumber),
+		Headers:    c.Headers,
+	}
+}
+
+// MultipartUpload is an upload in progress. Credentials are only set by repositories
+// handing out their own part upload URLs, e.g. presigned object store URLs.
+type MultipartUpload struct {
+	UploadID    string
+	Credentials []*MultipartUploadCredential
+}
+
+// MultipartUploadPartChecksums are the digests a client computed over the content of a part,
+// which is rejected unless it matches them. The digests the client didn't send are nil.
+type MultipartUploadPartChecksums struct {
+	MD5    []byte
+	SHA256 []byte
+}
+
+type MultipartUploadPart struct {
+	PartNumber int64
+	ETag       string
+}
+
+func MultipartUploadPartsFromProto(protoParts []*artifacts.MultipartUploadPart) []*MultipartUploadPart {
+	parts := make([]*MultipartUploadPart, 0, len(protoParts))
+	for _, part := range protoParts {
+		parts = append(parts, &MultipartUploadPart{
+			PartNumber: part.GetPartNumber(),
+			ETag:       part.GetEtag(),
+		})
+	}
+
+	return parts
+}
@@ -0,0 +1,10 @@
+package entities
+
+// SearchExplanation is the SQL query of a search, which is not run, for tuning the database.
+type SearchExplanation struct {
+	SQL    string `json:"sql"`
+	Params []any  `json:"params"`
+	// Plan is the plan of the database for the query, one line per step, when the dialect
+	// can explain queries.
+	Plan []string `json:"plan,omitempty"`
+}
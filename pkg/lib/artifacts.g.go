@@ -3,3 +3,15 @@
 package main
 
 import "C"
+import (
+	"unsafe"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos/artifacts"
+)
+//export ArtifactsServiceListArtifacts
+func ArtifactsServiceListArtifacts(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := artifactsServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.ListArtifacts, new(artifacts.ListArtifacts), requestData, requestSize, responseSize)
+}
@@ -167,6 +167,14 @@ func TrackingServiceSearchRuns(serviceID int64, requestData unsafe.Pointer, requ
 	}
 	return invokeServiceMethod(service.SearchRuns, new(protos.SearchRuns), requestData, requestSize, responseSize)
 }
+//export TrackingServiceListArtifacts
+func TrackingServiceListArtifacts(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.ListArtifacts, new(protos.ListArtifacts), requestData, requestSize, responseSize)
+}
 //export TrackingServiceGetMetricHistory
 func TrackingServiceGetMetricHistory(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := trackingServices.Get(serviceID)
@@ -175,6 +183,14 @@ func TrackingServiceGetMetricHistory(serviceID int64, requestData unsafe.Pointer
 	}
 	return invokeServiceMethod(service.GetMetricHistory, new(protos.GetMetricHistory), requestData, requestSize, responseSize)
 }
+//export TrackingServiceGetMetricHistoryBulkInterval
+func TrackingServiceGetMetricHistoryBulkInterval(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.GetMetricHistoryBulkInterval, new(protos.GetMetricHistoryBulkInterval), requestData, requestSize, responseSize)
+}
 //export TrackingServiceLogBatch
 func TrackingServiceLogBatch(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := trackingServices.Get(serviceID)
@@ -223,6 +239,22 @@ func TrackingServiceGetTraceInfoV3(serviceID int64, requestData unsafe.Pointer,
 	}
 	return invokeServiceMethod(service.GetTraceInfoV3, new(protos.GetTraceInfoV3), requestData, requestSize, responseSize)
 }
+//export TrackingServiceSearchTraces
+func TrackingServiceSearchTraces(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SearchTraces, new(protos.SearchTraces), requestData, requestSize, responseSize)
+}
+//export TrackingServiceSearchTracesV3
+func TrackingServiceSearchTracesV3(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SearchTracesV3, new(protos.SearchTracesV3), requestData, requestSize, responseSize)
+}
 //export TrackingServiceStartTraceV3
 func TrackingServiceStartTraceV3(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := trackingServices.Get(serviceID)
@@ -231,6 +263,14 @@ func TrackingServiceStartTraceV3(serviceID int64, requestData unsafe.Pointer, re
 	}
 	return invokeServiceMethod(service.StartTraceV3, new(protos.StartTraceV3), requestData, requestSize, responseSize)
 }
+//export TrackingServiceSearchUnifiedTraceHandler
+func TrackingServiceSearchUnifiedTraceHandler(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SearchUnifiedTraceHandler, new(protos.SearchUnifiedTraces), requestData, requestSize, responseSize)
+}
 //export TrackingServiceDeleteTraces
 func TrackingServiceDeleteTraces(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := trackingServices.Get(serviceID)
@@ -239,3 +279,67 @@ func TrackingServiceDeleteTraces(serviceID int64, requestData unsafe.Pointer, re
 	}
 	return invokeServiceMethod(service.DeleteTraces, new(protos.DeleteTraces), requestData, requestSize, responseSize)
 }
+//export TrackingServiceCreateLoggedModel
+func TrackingServiceCreateLoggedModel(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.CreateLoggedModel, new(protos.CreateLoggedModel), requestData, requestSize, responseSize)
+}
+//export TrackingServiceFinalizeLoggedModel
+func TrackingServiceFinalizeLoggedModel(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.FinalizeLoggedModel, new(protos.FinalizeLoggedModel), requestData, requestSize, responseSize)
+}
+//export TrackingServiceGetLoggedModel
+func TrackingServiceGetLoggedModel(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.GetLoggedModel, new(protos.GetLoggedModel), requestData, requestSize, responseSize)
+}
+//export TrackingServiceDeleteLoggedModel
+func TrackingServiceDeleteLoggedModel(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.DeleteLoggedModel, new(protos.DeleteLoggedModel), requestData, requestSize, responseSize)
+}
+//export TrackingServiceSearchLoggedModels
+func TrackingServiceSearchLoggedModels(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SearchLoggedModels, new(protos.SearchLoggedModels), requestData, requestSize, responseSize)
+}
+//export TrackingServiceSetLoggedModelTags
+func TrackingServiceSetLoggedModelTags(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SetLoggedModelTags, new(protos.SetLoggedModelTags), requestData, requestSize, responseSize)
+}
+//export TrackingServiceDeleteLoggedModelTag
+func TrackingServiceDeleteLoggedModelTag(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.DeleteLoggedModelTag, new(protos.DeleteLoggedModelTag), requestData, requestSize, responseSize)
+}
+//export TrackingServiceLogLoggedModelParams
+func TrackingServiceLogLoggedModelParams(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.LogLoggedModelParams, new(protos.LogLoggedModelParamsRequest), requestData, requestSize, responseSize)
+}
@@ -6,15 +6,45 @@ import (
 	"strings"
 
 	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
 	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql/models"
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
 	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
 
+func (m *ModelRegistryService) CreateModelVersion(
+	ctx context.Context, input *protos.CreateModelVersion,
+) (*protos.CreateModelVersion_Response, *contract.Error) {
+	tags := make([]*entities.ModelVersionTag, 0, len(input.GetTags()))
+	for _, tag := range input.GetTags() {
+		tags = append(tags, entities.NewModelVersionTagFromProto(tag))
+	}
+
+	modelVersion, err := m.store.CreateModelVersion(
+		ctx,
+		input.GetName(),
+		input.GetSource(),
+		input.GetRunId(),
+		tags,
+		input.GetRunLink(),
+		input.GetDescription(),
+		input.GetModelId(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.CreateModelVersion_Response{
+		ModelVersion: modelVersion.ToProto(),
+	}, nil
+}
+
 func (m *ModelRegistryService) GetLatestVersions(
 	ctx context.Context, input *protos.GetLatestVersions,
 ) (*protos.GetLatestVersions_Response, *contract.Error) {
-	latestVersions, err := m.store.GetLatestVersions(ctx, input.GetName(), input.GetStages())
+	latestVersions, err := m.store.GetLatestVersions(
+		utils.NewContextWithReplicaReads(ctx), input.GetName(), input.GetStages(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +72,9 @@ func (m *ModelRegistryService) DeleteModelVersion(
 func (m *ModelRegistryService) GetModelVersion(
 	ctx context.Context, input *protos.GetModelVersion,
 ) (*protos.GetModelVersion_Response, *contract.Error) {
-	modelVersion, err := m.store.GetModelVersion(ctx, input.GetName(), input.GetVersion(), true)
+	modelVersion, err := m.store.GetModelVersion(
+		utils.NewContextWithReplicaReads(ctx), input.GetName(), input.GetVersion(), true,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -52,6 +84,32 @@ func (m *ModelRegistryService) GetModelVersion(
 	}, nil
 }
 
+func (m *ModelRegistryService) SearchModelVersions(
+	ctx context.Context, input *protos.SearchModelVersions,
+) (*protos.SearchModelVersions_Response, *contract.Error) {
+	modelVersions, nextPageToken, err := m.store.SearchModelVersions(
+		utils.NewContextWithReplicaReads(ctx),
+		input.GetFilter(), input.GetMaxResults(), input.GetOrderBy(), input.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.SearchModelVersions_Response{
+		ModelVersions: make([]*protos.ModelVersion, 0, len(modelVersions)),
+	}
+
+	for _, modelVersion := range modelVersions {
+		response.ModelVersions = append(response.ModelVersions, modelVersion.ToProto())
+	}
+
+	if nextPageToken != "" {
+		response.NextPageToken = &nextPageToken
+	}
+
+	return &response, nil
+}
+
 func (m *ModelRegistryService) UpdateModelVersion(
 	ctx context.Context, input *protos.UpdateModelVersion,
 ) (*protos.UpdateModelVersion_Response, *contract.Error) {
@@ -113,7 +171,9 @@ func (m *ModelRegistryService) DeleteModelVersionTag(
 func (m *ModelRegistryService) GetModelVersionByAlias(
 	ctx context.Context, input *protos.GetModelVersionByAlias,
 ) (*protos.GetModelVersionByAlias_Response, *contract.Error) {
-	modelVersion, err := m.store.GetModelVersionByAlias(ctx, input.GetName(), input.GetAlias())
+	modelVersion, err := m.store.GetModelVersionByAlias(
+		utils.NewContextWithReplicaReads(ctx), input.GetName(), input.GetAlias(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +203,9 @@ func (m *ModelRegistryService) SetModelVersionTag(
 func (m *ModelRegistryService) GetModelVersionDownloadUri(
 	ctx context.Context, input *protos.GetModelVersionDownloadUri,
 ) (*protos.GetModelVersionDownloadUri_Response, *contract.Error) {
-	artifactURI, err := m.store.GetModelVersionDownloadURI(ctx, input.GetName(), input.GetVersion())
+	artifactURI, err := m.store.GetModelVersionDownloadURI(
+		utils.NewContextWithReplicaReads(ctx), input.GetName(), input.GetVersion(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/mlflow/mlflow-go-backend/pkg/contract"
 	"github.com/mlflow/mlflow-go-backend/pkg/entities"
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
 
 var (
@@ -54,7 +55,7 @@ func (m *ModelRegistryService) DeleteRegisteredModel(
 func (m *ModelRegistryService) GetRegisteredModel(
 	ctx context.Context, input *protos.GetRegisteredModel,
 ) (*protos.GetRegisteredModel_Response, *contract.Error) {
-	registeredModel, err := m.store.GetRegisteredModel(ctx, input.GetName())
+	registeredModel, err := m.store.GetRegisteredModel(utils.NewContextWithReplicaReads(ctx), input.GetName())
 	if err != nil {
 		return nil, err
 	}
@@ -64,6 +65,32 @@ func (m *ModelRegistryService) GetRegisteredModel(
 	}, nil
 }
 
+func (m *ModelRegistryService) SearchRegisteredModels(
+	ctx context.Context, input *protos.SearchRegisteredModels,
+) (*protos.SearchRegisteredModels_Response, *contract.Error) {
+	registeredModels, nextPageToken, err := m.store.SearchRegisteredModels(
+		utils.NewContextWithReplicaReads(ctx),
+		input.GetFilter(), input.GetMaxResults(), input.GetOrderBy(), input.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.SearchRegisteredModels_Response{
+		RegisteredModels: make([]*protos.RegisteredModel, 0, len(registeredModels)),
+	}
+
+	for _, registeredModel := range registeredModels {
+		response.RegisteredModels = append(response.RegisteredModels, registeredModel.ToProto())
+	}
+
+	if nextPageToken != "" {
+		response.NextPageToken = &nextPageToken
+	}
+
+	return &response, nil
+}
+
 func (m *ModelRegistryService) SetRegisteredModelTag(
 	ctx context.Context, input *protos.SetRegisteredModelTag,
 ) (*protos.SetRegisteredModelTag_Response, *contract.Error) {
@@ -0,0 +1,301 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	trackingModels "github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+)
+
+const modelsURIScheme = "models"
+
+var multipleSlashesRegex = regexp.MustCompile(`/+`)
+
+// isLocalURI reports whether uri points to the filesystem of the server, like the Python server does.
+func isLocalURI(uri string) bool {
+	parsedURI, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+
+	hostname := parsedURI.Hostname()
+	isRemoteHostname := hostname != "" && hostname != "." &&
+		!strings.HasPrefix(hostname, "localhost") && !strings.HasPrefix(hostname, "127.0.0.1")
+
+	switch parsedURI.Scheme {
+	case "":
+		return true
+	case "file":
+		return !isRemoteHostname
+	default:
+		return false
+	}
+}
+
+func localURIToPath(uri string) string {
+	if parsedURI, err := url.Parse(uri); err == nil && parsedURI.Scheme == "file" {
+		return filepath.Clean(filepath.FromSlash(parsedURI.Path))
+	}
+
+	return filepath.Clean(uri)
+}
+
+func invalidLocalSourceError(source string) *contract.Error {
+	return contract.NewError(
+		protos.ErrorCode_INVALID_PARAMETER_VALUE,
+		fmt.Sprintf(
+			"Invalid model version source: '%s'. To use a local path as a model version source, the run_id "+
+				"request parameter has to be specified and the local path has to be contained within the "+
+				"artifact directory of the run specified by the run_id.",
+			source,
+		),
+	)
+}
+
+// validateLocalSource makes sure a local source lies within the local artifactLocation
+// of the run or logged model the version is created from.
+func validateLocalSource(source, artifactLocation string) *contract.Error {
+	if artifactLocation == "" || !isLocalURI(artifactLocation) {
+		return invalidLocalSourceError(source)
+	}
+
+	sourcePath, err := filepath.Abs(localURIToPath(source))
+	if err != nil {
+		return invalidLocalSourceError(source)
+	}
+
+	artifactPath, err := filepath.Abs(localURIToPath(artifactLocation))
+	if err != nil {
+		return invalidLocalSourceError(source)
+	}
+
+	relativePath, err := filepath.Rel(artifactPath, sourcePath)
+	if err != nil || relativePath == ".." || strings.HasPrefix(relativePath, ".."+string(filepath.Separator)) {
+		return invalidLocalSourceError(source)
+	}
+
+	return nil
+}
+
+// validateRemoteSource rejects sources with relative path references, which could make
+// a model version point outside of the location it claims to be stored at.
+func validateRemoteSource(source string) *contract.Error {
+	invalidSourceError := contract.NewError(
+		protos.ErrorCode_INVALID_PARAMETER_VALUE,
+		fmt.Sprintf(
+			"Invalid model version source: '%s'. If supplying a source as an http, https, local file path, "+
+				"ftp, objectstore, or mlflow-artifacts uri, an absolute path must be provided without relative "+
+				"path references present. Please provide an absolute path.",
+			source,
+		),
+	)
+
+	decoded := source
+	for {
+		// like Python's unquote_plus, invalid escape sequences are kept as they are.
+		unquoted, err := url.QueryUnescape(decoded)
+		if err != nil || unquoted == decoded {
+			break
+		}
+
+		decoded = unquoted
+	}
+
+	for _, component := range strings.Split(decoded, "/") {
+		if component == ".." {
+			return invalidSourceError
+		}
+	}
+
+	parsedURI, err := url.Parse(decoded)
+	if err != nil {
+		return invalidSourceError
+	}
+
+	// the path of URIs like `scheme:relative/path` is opaque.
+	sourcePath := parsedURI.Path
+	if parsedURI.Opaque != "" {
+		sourcePath = parsedURI.Opaque
+	}
+
+	sourcePath = multipleSlashesRegex.ReplaceAllString(strings.TrimRight(sourcePath, "/"), "/")
+	if strings.Contains(sourcePath, "\x00") ||
+		(sourcePath != "" && (!path.IsAbs(sourcePath) || path.Clean(sourcePath) != sourcePath)) {
+		return invalidSourceError
+	}
+
+	return nil
+}
+
+func (m *ModelRegistrySQLStore) getRunArtifactURI(ctx context.Context, runID string) (string, *contract.Error) {
+	var run trackingModels.Run
+	if err := m.trackingDB.WithContext(ctx).Select(
+		"artifact_uri",
+	).Where(
+		"run_uuid = ?", runID,
+	).First(&run).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("Run with id=%s not found", runID),
+			)
+		}
+
+		return "", contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to get run", err)
+	}
+
+	return run.ArtifactURI, nil
+}
+
+func (m *ModelRegistrySQLStore) getLoggedModel(
+	ctx context.Context, modelID string,
+) (*trackingModels.LoggedModel, *contract.Error) {
+	var loggedModel trackingModels.LoggedModel
+	if err := m.trackingDB.WithContext(ctx).Where("model_id = ?", modelID).First(&loggedModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("Logged model with ID '%s' not found.", modelID),
+			)
+		}
+
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to get logged model", err)
+	}
+
+	return &loggedModel, nil
+}
+
+// validateModelVersionSource checks that the source of a new model version is consistent with the
+// artifact location of the run, or the logged model when modelID is given, it is created from.
+func (m *ModelRegistrySQLStore) validateModelVersionSource(
+	ctx context.Context, source, runID, modelID string,
+) *contract.Error {
+	if !isLocalURI(source) {
+		return validateRemoteSource(source)
+	}
+
+	var artifactLocation string
+
+	switch {
+	case modelID != "":
+		loggedModel, err := m.getLoggedModel(ctx, modelID)
+		if err != nil {
+			return err
+		}
+
+		artifactLocation = loggedModel.ArtifactLocation
+	case runID != "":
+		artifactURI, err := m.getRunArtifactURI(ctx, runID)
+		if err != nil {
+			return err
+		}
+
+		artifactLocation = artifactURI
+	}
+
+	return validateLocalSource(source, artifactLocation)
+}
+
+// resolveStorageLocation returns where the artifacts of a model version created from source are stored.
+// Sources referencing another model, e.g. `models:/name/1` or `models:/m-<id>`, are resolved to the
+// storage location of that model, other sources are stored in place. The run ID of a logged model is
+// used when runID is empty.
+//
+//nolint:cyclop
+func (m *ModelRegistrySQLStore) resolveStorageLocation(
+	ctx context.Context, source, runID string,
+) (string, string, *contract.Error) {
+	parsedURI, err := url.Parse(source)
+	if err != nil || parsedURI.Scheme != modelsURIScheme {
+		return source, runID, nil
+	}
+
+	unableToFetchError := func(err *contract.Error) *contract.Error {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Unable to fetch model from model URI source artifact location '%s'.", source),
+			err,
+		)
+	}
+
+	modelPath := strings.Trim(parsedURI.Path, "/")
+	name, versionOrStage, hasVersion := strings.Cut(modelPath, "/")
+
+	switch {
+	case !hasVersion && strings.Contains(name, "@"):
+		name, alias, _ := strings.Cut(name, "@")
+
+		modelVersion, err := m.GetModelVersionByAlias(ctx, name, alias)
+		if err != nil {
+			return "", "", unableToFetchError(err)
+		}
+
+		return modelVersion.StorageLocationOrSource(), runID, nil
+	case !hasVersion && name != "":
+		loggedModel, err := m.getLoggedModel(ctx, name)
+		if err != nil {
+			return "", "", unableToFetchError(err)
+		}
+
+		if runID == "" {
+			runID = loggedModel.SourceRunID.String
+		}
+
+		return loggedModel.ArtifactLocation, runID, nil
+	case hasVersion && isVersionNumber(versionOrStage):
+		storageLocation, err := m.GetModelVersionDownloadURI(ctx, name, versionOrStage)
+		if err != nil {
+			return "", "", unableToFetchError(err)
+		}
+
+		return storageLocation, runID, nil
+	case hasVersion:
+		var stages []string
+		if !strings.EqualFold(versionOrStage, "latest") {
+			stages = []string{versionOrStage}
+		}
+
+		latestVersions, err := m.GetLatestVersions(ctx, name, stages)
+		if err != nil {
+			return "", "", unableToFetchError(err)
+		}
+
+		if len(latestVersions) == 0 {
+			return "", "", unableToFetchError(contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("No versions of model with name '%s' and stage '%s' found", name, versionOrStage),
+			))
+		}
+
+		latestVersion := latestVersions[0]
+		for _, modelVersion := range latestVersions[1:] {
+			if modelVersion.Version > latestVersion.Version {
+				latestVersion = modelVersion
+			}
+		}
+
+		return latestVersion.StorageLocationOrSource(), runID, nil
+	default:
+		return "", "", unableToFetchError(contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Not a proper models:/ URI: %s", source),
+		))
+	}
+}
+
+func isVersionNumber(value string) bool {
+	version, err := strconv.Atoi(value)
+
+	return err == nil && version > 0
+}
@@ -0,0 +1,77 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+func TestIsLocalURI(t *testing.T) {
+	t.Parallel()
+
+	for uri, expected := range map[string]bool{
+		"/mlruns/1/abc/artifacts/model":           true,
+		"relative/model":                          true,
+		"file:///mlruns/1/abc/artifacts/model":    true,
+		"file://localhost/mlruns/1/abc/artifacts": true,
+		"file://remote-host/mlruns/1/abc":         false,
+		"s3://bucket/1/abc/artifacts/model":       false,
+		"runs:/abc/model":                         false,
+		"models:/name/1":                          false,
+	} {
+		assert.Equal(t, expected, isLocalURI(uri), uri)
+	}
+}
+
+func TestValidateLocalSource(t *testing.T) {
+	t.Parallel()
+
+	for _, source := range []string{
+		"/mlruns/1/abc/artifacts",
+		"/mlruns/1/abc/artifacts/model",
+		"file:///mlruns/1/abc/artifacts/model",
+	} {
+		assert.Nil(t, validateLocalSource(source, "file:///mlruns/1/abc/artifacts"), source)
+	}
+
+	for source, artifactLocation := range map[string]string{
+		"/mlruns/1/other/artifacts/model":         "/mlruns/1/abc/artifacts",
+		"/mlruns/1/abc/artifacts/../../other":     "/mlruns/1/abc/artifacts",
+		"/mlruns/1/abc/artifacts-other/model":     "/mlruns/1/abc/artifacts",
+		"/mlruns/1/abc/artifacts/model":           "s3://bucket/mlruns/1/abc/artifacts",
+		"/mlruns/1/abc/artifacts/model/no-run-id": "",
+	} {
+		contractError := validateLocalSource(source, artifactLocation)
+		require.NotNil(t, contractError, source)
+		assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractError.Code), source)
+	}
+}
+
+func TestValidateRemoteSource(t *testing.T) {
+	t.Parallel()
+
+	for _, source := range []string{
+		"s3://bucket/1/abc/artifacts/model",
+		"s3://bucket/1/abc/artifacts/model/",
+		"runs:/abc/model",
+		"mlflow-artifacts:/1/abc/artifacts/model",
+		"https://host/models/model%20name",
+	} {
+		assert.Nil(t, validateRemoteSource(source), source)
+	}
+
+	for _, source := range []string{
+		"s3://bucket/1/abc/../other/model",
+		"s3://bucket/1/abc/%2E%2E/other/model",
+		"s3://bucket/1/abc/%252E%252E/other/model",
+		"s3://bucket/1/abc/./model",
+		"mlflow-artifacts:relative/model",
+	} {
+		contractError := validateRemoteSource(source)
+		require.NotNil(t, contractError, source)
+		assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractError.Code), source)
+	}
+}
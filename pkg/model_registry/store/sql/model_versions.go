@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -18,6 +19,130 @@ import (
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
 )
 
+var errRegisteredModelNotFound = errors.New("registered model not found")
+
+// CreateModelVersion registers source as the next version of the registered model. Version numbers
+// are never reused, so the next one is allocated after the highest version, including deleted ones.
+//
+//nolint:funlen,cyclop
+func (m *ModelRegistrySQLStore) CreateModelVersion(
+	ctx context.Context,
+	name, source, runID string,
+	tags []*entities.ModelVersionTag,
+	runLink, description, modelID string,
+) (*entities.ModelVersion, *contract.Error) {
+	// prompts are not backed by artifacts, so their source is not validated.
+	isPrompt := slices.ContainsFunc(tags, func(tag *entities.ModelVersionTag) bool {
+		return tag.Key == IsPromptTagKey
+	})
+	if !isPrompt {
+		if err := m.validateModelVersionSource(ctx, source, runID, modelID); err != nil {
+			return nil, err
+		}
+	}
+
+	storageLocation, runID, contractError := m.resolveStorageLocation(ctx, source, runID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	creationTime := time.Now().UnixMilli()
+	modelVersion := models.ModelVersion{
+		Name:            name,
+		CreationTime:    creationTime,
+		LastUpdatedTime: creationTime,
+		Description:     sql.NullString{String: description, Valid: description != ""},
+		CurrentStage:    models.ModelVersionStageNone,
+		Source:          source,
+		RunID:           runID,
+		Status:          protos.ModelVersionStatus_READY.String(),
+		RunLink:         runLink,
+		StorageLocation: storageLocation,
+	}
+
+	// the last value of a duplicated tag key wins.
+	tagIndexes := map[string]int{}
+
+	for _, tag := range tags {
+		if index, ok := tagIndexes[tag.Key]; ok {
+			modelVersion.Tags[index].Value = tag.Value
+
+			continue
+		}
+
+		tagIndexes[tag.Key] = len(modelVersion.Tags)
+		modelVersion.Tags = append(modelVersion.Tags, models.ModelVersionTag{
+			Key:   tag.Key,
+			Value: tag.Value,
+			Name:  name,
+		})
+	}
+
+	// a concurrent creation may take the same version number, the transaction then allocates the next one.
+	err := m.transactionRetryingOn(ctx, func(transaction *gorm.DB) error {
+		// locking the registered model serializes concurrent creations of its versions,
+		// the retries cover databases without row level locking.
+		var registeredModel models.RegisteredModel
+		if err := transaction.Clauses(
+			clause.Locking{Strength: "UPDATE"},
+		).Where(
+			"name = ?", name,
+		).First(&registeredModel).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errRegisteredModelNotFound
+			}
+
+			return err
+		}
+
+		var maxVersion sql.NullInt32
+		if err := transaction.Model(
+			&models.ModelVersion{},
+		).Where(
+			"name = ?", name,
+		).Select(
+			"MAX(version)",
+		).Scan(&maxVersion).Error; err != nil {
+			return err
+		}
+
+		modelVersion.Version = maxVersion.Int32 + 1
+		for index := range modelVersion.Tags {
+			modelVersion.Tags[index].Version = modelVersion.Version
+		}
+
+		if err := transaction.Create(&modelVersion).Error; err != nil {
+			return err
+		}
+
+		return transaction.Model(
+			&models.RegisteredModel{},
+		).Where(
+			"name = ?", name,
+		).Update(
+			"last_updated_time", creationTime,
+		).Error
+	}, gorm.ErrDuplicatedKey)
+
+	switch {
+	case err == nil:
+		return modelVersion.ToEntity(), nil
+	case errors.Is(err, errRegisteredModelNotFound):
+		return nil, contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("Registered Model with name=%s not found", name),
+		)
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("Model Version creation error (name=%s). Giving up after retrying.", name),
+			err,
+		)
+	default:
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to create model version", err)
+	}
+}
+
 func (m *ModelRegistrySQLStore) GetLatestVersions(
 	ctx context.Context, name string, stages []string,
 ) ([]*entities.ModelVersion, *contract.Error) {
@@ -27,8 +152,8 @@ func (m *ModelRegistrySQLStore) GetLatestVersions(
 
 	var modelVersions []*models.ModelVersion
 
-	subQuery := m.db.
-		WithContext(ctx).
+	database := m.reader(ctx)
+	subQuery := database.
 		Model(&models.ModelVersion{}).
 		Select("name, MAX(version) AS max_version").
 		Where("name = ?", name).
@@ -57,8 +182,7 @@ func (m *ModelRegistrySQLStore) GetLatestVersions(
 		subQuery = subQuery.Where("current_stage IN (?)", stages)
 	}
 
-	err := m.db.
-		WithContext(ctx).
+	err := database.
 		Model(&models.ModelVersion{}).
 		Joins("JOIN (?) AS sub ON model_versions.name = sub.name AND model_versions.version = sub.max_version", subQuery).
 		Find(&modelVersions).Error
@@ -81,9 +205,9 @@ func (m *ModelRegistrySQLStore) GetLatestVersions(
 func (m *ModelRegistrySQLStore) GetModelVersion(
 	ctx context.Context, name, version string, eager bool,
 ) (*entities.ModelVersion, *contract.Error) {
-	query := m.db.WithContext(
-		ctx,
-	).Where(
+	database := m.reader(ctx)
+
+	query := database.Where(
 		"name = ?", name,
 	).Where(
 		"version = ?", version,
@@ -115,7 +239,7 @@ func (m *ModelRegistrySQLStore) GetModelVersion(
 	}
 
 	var registeredModelAliases []models.RegisteredModelAlias
-	if err := m.db.WithContext(ctx).Where(
+	if err := database.Where(
 		"name = ?", modelVersion.Name,
 	).Where(
 		"version = ?", modelVersion.Version,
@@ -145,7 +269,7 @@ func (m *ModelRegistrySQLStore) DeleteModelVersion(ctx context.Context, name, ve
 		return err
 	}
 
-	if err := m.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := m.transaction(ctx, func(transaction *gorm.DB) error {
 		if err := transaction.Model(
 			&models.RegisteredModel{},
 		).Where(
@@ -247,7 +371,7 @@ Valid stages are %s`,
 		return nil, err
 	}
 
-	if err := m.db.Transaction(func(transaction *gorm.DB) error {
+	if err := m.transaction(ctx, func(transaction *gorm.DB) error {
 		lastUpdatedTime := time.Now().UnixMilli()
 		if err := transaction.Model(
 			&models.RegisteredModel{},
@@ -326,9 +450,7 @@ func (m *ModelRegistrySQLStore) GetModelVersionByAlias(
 	ctx context.Context, name, alias string,
 ) (*entities.ModelVersion, *contract.Error) {
 	var registeredModelAlias models.RegisteredModelAlias
-	if err := m.db.WithContext(
-		ctx,
-	).Where(
+	if err := m.reader(ctx).Where(
 		"name = ?", name,
 	).Where(
 		"alias = ?", alias,
@@ -394,9 +516,109 @@ func (m *ModelRegistrySQLStore) GetModelVersionDownloadURI(
 		return "", err
 	}
 
-	if modelVersion.StorageLocation != "" {
-		return modelVersion.StorageLocation, nil
+	return modelVersion.StorageLocationOrSource(), nil
+}
+
+//nolint:funlen
+func (m *ModelRegistrySQLStore) SearchModelVersions(
+	ctx context.Context, filter string, maxResults int64, orderBy []string, pageToken string,
+) ([]*entities.ModelVersion, string, *contract.Error) {
+	if maxResults < 1 || maxResults > SearchModelVersionsMaxResultsThreshold {
+		return nil, "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Invalid value for request parameter max_results. "+
+					"It must be at most %d, but got value %d",
+				SearchModelVersionsMaxResultsThreshold,
+				maxResults,
+			),
+		)
+	}
+
+	offset, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	limit := int(maxResults)
+	transaction := m.reader(ctx).Model(
+		&models.ModelVersion{},
+	).Where(
+		"model_versions.current_stage != ?", models.StageDeletedInternal,
+	).Offset(offset).Limit(limit + 1)
+
+	if err := applyModelVersionsFilter(ctx, m.db, transaction, filter); err != nil {
+		return nil, "", err
+	}
+
+	if err := applyModelVersionsOrderBy(transaction, orderBy); err != nil {
+		return nil, "", err
 	}
 
-	return modelVersion.Source, nil
+	var modelVersions []models.ModelVersion
+	if err := transaction.Preload("Tags").Find(&modelVersions).Error; err != nil {
+		return nil, "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"failed to search model versions",
+			err,
+		)
+	}
+
+	nextPageToken, err := encodePageToken(len(modelVersions), limit, offset)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(modelVersions) > limit {
+		modelVersions = modelVersions[:limit]
+	}
+
+	if err := loadModelVersionsAliases(transaction.Session(&gorm.Session{NewDB: true}), modelVersions); err != nil {
+		return nil, "", err
+	}
+
+	result := make([]*entities.ModelVersion, 0, len(modelVersions))
+	for _, modelVersion := range modelVersions {
+		result = append(result, modelVersion.ToEntity())
+	}
+
+	return result, nextPageToken, nil
+}
+
+// loadModelVersionsAliases fetches the aliases of all the registered models of the model versions at
+// once from database, instead of querying them version by version.
+func loadModelVersionsAliases(database *gorm.DB, modelVersions []models.ModelVersion) *contract.Error {
+	if len(modelVersions) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(modelVersions))
+	for _, modelVersion := range modelVersions {
+		if !slices.Contains(names, modelVersion.Name) {
+			names = append(names, modelVersion.Name)
+		}
+	}
+
+	var registeredModelAliases []models.RegisteredModelAlias
+	if err := database.Where(
+		"name IN (?)", names,
+	).Find(
+		&registeredModelAliases,
+	).Error; err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"failed to get Registered Model Aliases",
+			err,
+		)
+	}
+
+	for index := range modelVersions {
+		for _, alias := range registeredModelAliases {
+			if alias.Name == modelVersions[index].Name && alias.Version == modelVersions[index].Version {
+				modelVersions[index].Aliases = append(modelVersions[index].Aliases, alias)
+			}
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,163 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
+)
+
+// SearchModelVersionsMaxResultsThreshold is the largest page size of SearchModelVersions.
+const SearchModelVersionsMaxResultsThreshold = 200000
+
+// canonicalStages converts the stages of a current_stage condition to the values stored in the database.
+func canonicalStages(value any) (any, *contract.Error) {
+	convert := func(stage string) (models.ModelVersionStage, *contract.Error) {
+		canonicalStage, ok := models.CanonicalMapping[strings.ToLower(stage)]
+		if !ok {
+			return "", contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid Model Version stage: %s. Value must be one of %s.", stage, models.AllModelVersionStages()),
+			)
+		}
+
+		return canonicalStage, nil
+	}
+
+	switch typedValue := value.(type) {
+	case string:
+		return convert(typedValue)
+	case []string:
+		stages := make([]models.ModelVersionStage, 0, len(typedValue))
+
+		for _, stage := range typedValue {
+			canonicalStage, err := convert(stage)
+			if err != nil {
+				return nil, err
+			}
+
+			stages = append(stages, canonicalStage)
+		}
+
+		return stages, nil
+	default:
+		return value, nil
+	}
+}
+
+// modelVersionsFilterTranslator translates the comparisons of a model versions filter.
+// The subqueries select the matching versions in name and version.
+type modelVersionsFilterTranslator struct{}
+
+func (modelVersionsFilterTranslator) TranslateFilter(
+	database *gorm.DB, filterCondition *parser.ValidCompareExpr,
+) (*sql.Filter, *contract.Error) {
+	if filterCondition.Identifier == parser.Attribute {
+		where, value := sql.ComparisonCondition(database, "model_versions."+filterCondition.Key, filterCondition)
+
+		if filterCondition.Key == parser.ModelVersionCurrentStage {
+			var contractError *contract.Error
+			if value, contractError = canonicalStages(value); contractError != nil {
+				return nil, contractError
+			}
+		}
+
+		return &sql.Filter{Where: where, Value: value}, nil
+	}
+
+	return &sql.Filter{
+		Subquery: database.Model(
+			&models.ModelVersionTag{},
+		).Select(
+			"name", "version",
+		).Where(
+			"key = ?", filterCondition.Key,
+		).Where(
+			sql.ComparisonCondition(database, "value", filterCondition),
+		),
+	}, nil
+}
+
+var modelVersionsFilterEntity = &sql.FilterEntity{
+	Table:      "model_versions",
+	Keys:       []string{"name", "version"},
+	Schema:     parser.ModelVersionSchema,
+	Translator: modelVersionsFilterTranslator{},
+}
+
+func applyModelVersionsFilter(ctx context.Context, database, transaction *gorm.DB, filter string) *contract.Error {
+	return sql.ApplyFilter(ctx, database, transaction, modelVersionsFilterEntity, filter)
+}
+
+func applyModelVersionsOrderBy(transaction *gorm.DB, orderBy []string) *contract.Error {
+	if len(orderBy) == 0 {
+		orderBy = []string{"last_updated_timestamp DESC"}
+	}
+
+	orderedColumns := map[string]bool{}
+
+	for _, orderByClause := range orderBy {
+		parts := registryOrderBy.FindStringSubmatch(strings.TrimSpace(orderByClause))
+		if len(parts) == 0 {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid order_by clause '%s'", orderByClause),
+			)
+		}
+
+		var column string
+
+		switch parts[1] {
+		case "name":
+			column = "name"
+		case "version_number":
+			column = "version"
+		case "timestamp", "last_updated_timestamp":
+			column = "last_updated_time"
+		case "creation_timestamp":
+			column = "creation_time"
+		default:
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"Invalid order by key '%s' specified. Valid keys are "+
+						"['name', 'version_number', 'timestamp', 'last_updated_timestamp', 'creation_timestamp']",
+					parts[1],
+				),
+			)
+		}
+
+		if orderedColumns[column] {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("`order_by` contains duplicate fields: %v", orderBy),
+			)
+		}
+
+		orderedColumns[column] = true
+
+		transaction.Order(clause.OrderByColumn{
+			Column: clause.Column{Table: "model_versions", Name: column},
+			Desc:   strings.EqualFold(parts[2], "DESC"),
+		})
+	}
+
+	// name and version are the primary key, which makes the order, and therefore the pages, stable.
+	if !orderedColumns["name"] {
+		transaction.Order("model_versions.name ASC")
+	}
+
+	if !orderedColumns["version"] {
+		transaction.Order("model_versions.version DESC")
+	}
+
+	return nil
+}
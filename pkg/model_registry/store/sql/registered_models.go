@@ -26,9 +26,7 @@ func (m *ModelRegistrySQLStore) GetRegisteredModel(
 	ctx context.Context, name string,
 ) (*entities.RegisteredModel, *contract.Error) {
 	var registeredModel models.RegisteredModel
-	if err := m.db.WithContext(
-		ctx,
-	).Where(
+	if err := m.reader(ctx).Where(
 		"name = ?", name,
 	).Preload(
 		"Tags",
@@ -88,7 +86,7 @@ func (m *ModelRegistrySQLStore) RenameRegisteredModel(
 		return nil, err
 	}
 
-	if err := m.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := m.transaction(ctx, func(transaction *gorm.DB) error {
 		if err := transaction.Model(
 			&models.ModelVersion{},
 		).Where(
@@ -138,7 +136,7 @@ func (m *ModelRegistrySQLStore) DeleteRegisteredModel(ctx context.Context, name
 		return err
 	}
 
-	if err := m.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := m.transaction(ctx, func(transaction *gorm.DB) error {
 		if err := transaction.Where(
 			"name = ?", registeredModel.Name,
 		).Delete(
@@ -377,3 +375,68 @@ func (m *ModelRegistrySQLStore) DeleteRegisteredModelAlias(ctx context.Context,
 
 	return nil
 }
+
+func (m *ModelRegistrySQLStore) SearchRegisteredModels(
+	ctx context.Context, filter string, maxResults int64, orderBy []string, pageToken string,
+) ([]*entities.RegisteredModel, string, *contract.Error) {
+	if maxResults < 1 || maxResults > SearchRegisteredModelsMaxResultsThreshold {
+		return nil, "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Invalid value for request parameter max_results. "+
+					"It must be at most %d, but got value %d",
+				SearchRegisteredModelsMaxResultsThreshold,
+				maxResults,
+			),
+		)
+	}
+
+	offset, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	limit := int(maxResults)
+	transaction := m.reader(ctx).Model(&models.RegisteredModel{}).Offset(offset).Limit(limit + 1)
+
+	if err := applyRegisteredModelsFilter(ctx, m.db, transaction, filter); err != nil {
+		return nil, "", err
+	}
+
+	if err := applyRegisteredModelsOrderBy(transaction, orderBy); err != nil {
+		return nil, "", err
+	}
+
+	var registeredModels []models.RegisteredModel
+	if err := transaction.Preload(
+		"Tags",
+	).Preload(
+		"Aliases",
+	).Preload(
+		"Versions",
+	).Find(
+		&registeredModels,
+	).Error; err != nil {
+		return nil, "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"failed to search registered models",
+			err,
+		)
+	}
+
+	nextPageToken, err := encodePageToken(len(registeredModels), limit, offset)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(registeredModels) > limit {
+		registeredModels = registeredModels[:limit]
+	}
+
+	result := make([]*entities.RegisteredModel, 0, len(registeredModels))
+	for _, registeredModel := range registeredModels {
+		result = append(result, registeredModel.ToEntity())
+	}
+
+	return result, nextPageToken, nil
+}
@@ -0,0 +1,185 @@
+package sql
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
+)
+
+// SearchRegisteredModelsMaxResultsThreshold is the largest page size of SearchRegisteredModels.
+const SearchRegisteredModelsMaxResultsThreshold = 1000
+
+var registryOrderBy = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
+
+// PageToken is the decoded page_token of the search endpoints of the model registry.
+type PageToken struct {
+	Offset int32 `json:"offset"`
+}
+
+func decodePageToken(pageToken string) (int, *contract.Error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+
+	var token PageToken
+	if err := json.NewDecoder(
+		base64.NewDecoder(
+			base64.StdEncoding,
+			strings.NewReader(pageToken),
+		),
+	).Decode(&token); err != nil || token.Offset < 0 {
+		return 0, contract.NewErrorWith(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Invalid page token: %q", pageToken),
+			err,
+		)
+	}
+
+	return int(token.Offset), nil
+}
+
+// encodePageToken returns the token of the page following offset. Searches fetch one result
+// more than limit, so that no token is returned when there is no next page.
+//
+//nolint:gosec // disable G115
+func encodePageToken(resultsLength, limit, offset int) (string, *contract.Error) {
+	if resultsLength <= limit {
+		return "", nil
+	}
+
+	var token strings.Builder
+	if err := json.NewEncoder(
+		base64.NewEncoder(base64.StdEncoding, &token),
+	).Encode(PageToken{
+		Offset: int32(offset + limit),
+	}); err != nil {
+		return "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"error encoding 'nextPageToken' value",
+			err,
+		)
+	}
+
+	return token.String(), nil
+}
+
+// registeredModelsFilterTranslator translates the comparisons of a registered models filter.
+// The subqueries select the matching models in name.
+type registeredModelsFilterTranslator struct{}
+
+func (registeredModelsFilterTranslator) TranslateFilter(
+	database *gorm.DB, filterCondition *parser.ValidCompareExpr,
+) (*sql.Filter, *contract.Error) {
+	if filterCondition.Identifier == parser.Attribute {
+		where, value := sql.ComparisonCondition(database, "registered_models."+filterCondition.Key, filterCondition)
+
+		return &sql.Filter{Where: where, Value: value}, nil
+	}
+
+	// models which are not prompts usually don't have the tag at all, so the
+	// condition is negated to also match them.
+	if filterCondition.Key == IsPromptTagKey && filterCondition.Operator == parser.NotEquals {
+		return &sql.Filter{
+			Subquery: database.Model(
+				&models.RegisteredModelTag{},
+			).Select(
+				"name",
+			).Where(
+				"key = ?", filterCondition.Key,
+			).Where(
+				"value = ?", filterCondition.Value,
+			),
+			AntiJoin: true,
+		}, nil
+	}
+
+	return &sql.Filter{
+		Subquery: database.Model(
+			&models.RegisteredModelTag{},
+		).Select(
+			"name",
+		).Where(
+			"key = ?", filterCondition.Key,
+		).Where(
+			sql.ComparisonCondition(database, "value", filterCondition),
+		),
+	}, nil
+}
+
+var registeredModelsFilterEntity = &sql.FilterEntity{
+	Table:      "registered_models",
+	Keys:       []string{"name"},
+	Schema:     parser.RegisteredModelSchema,
+	Translator: registeredModelsFilterTranslator{},
+}
+
+func applyRegisteredModelsFilter(ctx context.Context, database, transaction *gorm.DB, filter string) *contract.Error {
+	return sql.ApplyFilter(ctx, database, transaction, registeredModelsFilterEntity, filter)
+}
+
+func applyRegisteredModelsOrderBy(transaction *gorm.DB, orderBy []string) *contract.Error {
+	orderedColumns := map[string]bool{}
+
+	for _, orderByClause := range orderBy {
+		parts := registryOrderBy.FindStringSubmatch(strings.TrimSpace(orderByClause))
+		if len(parts) == 0 {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid order_by clause '%s'", orderByClause),
+			)
+		}
+
+		var column string
+
+		switch parts[1] {
+		case "name":
+			column = "name"
+		case "timestamp", "last_updated_timestamp":
+			column = "last_updated_time"
+		case "creation_timestamp":
+			column = "creation_time"
+		default:
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"Invalid order by key '%s' specified. Valid keys are "+
+						"['name', 'timestamp', 'last_updated_timestamp', 'creation_timestamp']",
+					parts[1],
+				),
+			)
+		}
+
+		if orderedColumns[column] {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("`order_by` contains duplicate fields: %v", orderBy),
+			)
+		}
+
+		orderedColumns[column] = true
+
+		transaction.Order(clause.OrderByColumn{
+			Column: clause.Column{Table: "registered_models", Name: column},
+			Desc:   strings.EqualFold(parts[2], "DESC"),
+		})
+	}
+
+	// names are unique, which makes the order, and therefore the pages, stable.
+	if !orderedColumns["name"] {
+		transaction.Order("registered_models.name ASC")
+	}
+
+	return nil
+}
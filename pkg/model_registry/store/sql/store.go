@@ -2,6 +2,7 @@ package sql
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"gorm.io/gorm"
@@ -13,24 +14,85 @@ import (
 type ModelRegistrySQLStore struct {
 	config *config.Config
 	db     *gorm.DB
+	// trackingDB is used to look up the runs and logged models model versions are created from.
+	// It is db itself unless the tracking store lives in a different database.
+	trackingDB *gorm.DB
+	// replicas route the reads tolerating replication lag, nil unless the registry lives in the
+	// tracking store database and it has read replicas.
+	replicas *sql.ReadReplicas
 }
 
 func NewModelRegistrySQLStore(ctx context.Context, config *config.Config) (*ModelRegistrySQLStore, error) {
-	database, err := sql.NewDatabase(ctx, config.ModelRegistryStoreURI)
+	database, err := sql.NewDatabase(ctx, config.ModelRegistryStoreURI, config.Database)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database %q: %w", config.ModelRegistryStoreURI, err)
 	}
 
-	return &ModelRegistrySQLStore{
-		config: config,
-		db:     database,
-	}, nil
+	trackingDatabase := database
+	if config.TrackingStoreURI != config.ModelRegistryStoreURI {
+		trackingDatabase, err = sql.NewDatabase(ctx, config.TrackingStoreURI, config.Database)
+		if err != nil {
+			return nil, errors.Join(
+				fmt.Errorf("failed to connect to database %q: %w", config.TrackingStoreURI, err),
+				sql.CloseDatabase(database),
+			)
+		}
+	}
+
+	store := &ModelRegistrySQLStore{
+		config:     config,
+		db:         database,
+		trackingDB: trackingDatabase,
+	}
+
+	if config.TrackingStoreURI == config.ModelRegistryStoreURI && len(config.TrackingStoreReadReplicaURIs) > 0 {
+		store.replicas, err = sql.NewReadReplicas(ctx, database, config.TrackingStoreReadReplicaURIs, config.Database)
+		if err != nil {
+			// the replicas opened so far are closed by NewReadReplicas.
+			return nil, errors.Join(err, store.Destroy())
+		}
+	}
+
+	return store, nil
 }
 
 func (m *ModelRegistrySQLStore) Destroy() error {
+	if m.replicas != nil {
+		if err := m.replicas.Close(); err != nil {
+			return fmt.Errorf("failed to close read replicas: %w", err)
+		}
+	}
+
 	if err := sql.CloseDatabase(m.db); err != nil {
 		return fmt.Errorf("failed to close database: %w", err)
 	}
 
+	if m.trackingDB != m.db {
+		if err := sql.CloseDatabase(m.trackingDB); err != nil {
+			return fmt.Errorf("failed to close tracking database: %w", err)
+		}
+	}
+
 	return nil
 }
+
+// transaction runs fn in a transaction, retried on transient database errors, see sql.Transaction.
+func (m *ModelRegistrySQLStore) transaction(ctx context.Context, fn func(transaction *gorm.DB) error) error {
+	return sql.Transaction(m.db.WithContext(ctx), fn) //nolint:wrapcheck
+}
+
+// transactionRetryingOn runs fn like transaction, also running it again when it fails with one of errs.
+func (m *ModelRegistrySQLStore) transactionRetryingOn(
+	ctx context.Context, fn func(transaction *gorm.DB) error, errs ...error,
+) error {
+	return sql.TransactionRetryingOn(m.db.WithContext(ctx), fn, errs...) //nolint:wrapcheck
+}
+
+// reader returns the database the reads of ctx go to, a read replica when ctx allows it.
+func (m *ModelRegistrySQLStore) reader(ctx context.Context) *gorm.DB {
+	if m.replicas == nil {
+		return m.db.WithContext(ctx)
+	}
+
+	return m.replicas.DB(ctx)
+}
@@ -251,8 +251,8 @@ type CreateMultipartUpload struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path     *string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty" query:"path" params:"path"`
-	NumParts *int64  `protobuf:"varint,2,opt,name=num_parts,json=numParts" json:"num_parts,omitempty" query:"num_parts" params:"num_parts"`
+	Path     *string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty" query:"path" params:"path" validate:"required"`
+	NumParts *int64  `protobuf:"varint,2,opt,name=num_parts,json=numParts" json:"num_parts,omitempty" query:"num_parts" params:"num_parts" validate:"required,gt=0,max=10000"`
 }
 
 func (x *CreateMultipartUpload) Reset() {
@@ -304,9 +304,9 @@ type CompleteMultipartUpload struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path     *string                `protobuf:"bytes,1,opt,name=path" json:"path,omitempty" query:"path" params:"path"`
-	UploadId *string                `protobuf:"bytes,2,opt,name=upload_id,json=uploadId" json:"upload_id,omitempty" query:"upload_id" params:"upload_id"`
-	Parts    []*MultipartUploadPart `protobuf:"bytes,3,rep,name=parts" json:"parts,omitempty" query:"parts" params:"parts"`
+	Path     *string                `protobuf:"bytes,1,opt,name=path" json:"path,omitempty" query:"path" params:"path" validate:"required"`
+	UploadId *string                `protobuf:"bytes,2,opt,name=upload_id,json=uploadId" json:"upload_id,omitempty" query:"upload_id" params:"upload_id" validate:"required"`
+	Parts    []*MultipartUploadPart `protobuf:"bytes,3,rep,name=parts" json:"parts,omitempty" query:"parts" params:"parts" validate:"required"`
 }
 
 func (x *CompleteMultipartUpload) Reset() {
@@ -365,8 +365,8 @@ type AbortMultipartUpload struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path     *string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty" query:"path" params:"path"`
-	UploadId *string `protobuf:"bytes,2,opt,name=upload_id,json=uploadId" json:"upload_id,omitempty" query:"upload_id" params:"upload_id"`
+	Path     *string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty" query:"path" params:"path" validate:"required"`
+	UploadId *string `protobuf:"bytes,2,opt,name=upload_id,json=uploadId" json:"upload_id,omitempty" query:"upload_id" params:"upload_id" validate:"required"`
 }
 
 func (x *AbortMultipartUpload) Reset() {
@@ -377,9 +377,9 @@ type CreateMultipartUpload struct {
 	// Run ID
 	RunId *string `protobuf:"bytes,1,opt,name=run_id,json=runId" json:"run_id,omitempty" query:"run_id" params:"run_id"`
 	// Artifact path, relative to the Run's artifact root location (e.g. "path/to/file")
-	Path *string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty" query:"path" params:"path"`
+	Path *string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty" query:"path" params:"path" validate:"required"`
 	// Number of file parts (chunks of data) to upload in the initiated multipart upload
-	NumParts *int64 `protobuf:"varint,3,opt,name=num_parts,json=numParts" json:"num_parts,omitempty" query:"num_parts" params:"num_parts"`
+	NumParts *int64 `protobuf:"varint,3,opt,name=num_parts,json=numParts" json:"num_parts,omitempty" query:"num_parts" params:"num_parts" validate:"required,gt=0,max=10000"`
 }
 
 func (x *CreateMultipartUpload) Reset() {
@@ -494,9 +494,9 @@ type CompleteMultipartUpload struct {
 	// Run ID
 	RunId *string `protobuf:"bytes,1,opt,name=run_id,json=runId" json:"run_id,omitempty" query:"run_id" params:"run_id"`
 	// Artifact path, relative to the Run's artifact root location (e.g. "path/to/file")
-	Path *string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty" query:"path" params:"path"`
+	Path *string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty" query:"path" params:"path" validate:"required"`
 	// ID identifying the multipart upload to complete
-	UploadId *string `protobuf:"bytes,3,opt,name=upload_id,json=uploadId" json:"upload_id,omitempty" query:"upload_id" params:"upload_id"`
+	UploadId *string `protobuf:"bytes,3,opt,name=upload_id,json=uploadId" json:"upload_id,omitempty" query:"upload_id" params:"upload_id" validate:"required"`
 	// A list of file parts uploaded in the multipart upload to complete
 	PartEtags []*PartEtag `protobuf:"bytes,4,rep,name=part_etags,json=partEtags" json:"part_etags,omitempty" query:"part_etags" params:"part_etags"`
 }
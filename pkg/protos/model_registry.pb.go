@@ -1093,14 +1093,14 @@ type CreateModelVersion struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Register model under this name
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name" validate:"required"`
 	// URI indicating the location of the model artifacts.
-	Source *string `protobuf:"bytes,2,opt,name=source" json:"source,omitempty" query:"source" params:"source"`
+	Source *string `protobuf:"bytes,2,opt,name=source" json:"source,omitempty" query:"source" params:"source" validate:"required"`
 	// MLflow run ID for correlation, if “source“ was generated by an experiment run in
 	// MLflow tracking server
 	RunId *string `protobuf:"bytes,3,opt,name=run_id,json=runId" json:"run_id,omitempty" query:"run_id" params:"run_id"`
 	// Additional metadata for model version.
-	Tags []*ModelVersionTag `protobuf:"bytes,4,rep,name=tags" json:"tags,omitempty" query:"tags" params:"tags"`
+	Tags []*ModelVersionTag `protobuf:"bytes,4,rep,name=tags" json:"tags,omitempty" query:"tags" params:"tags" validate:"omitempty,dive"`
 	// MLflow run link - this is the exact link of the run that generated this model version,
 	// potentially hosted at another instance of MLflow.
 	RunLink *string `protobuf:"bytes,5,opt,name=run_link,json=runLink" json:"run_link,omitempty" query:"run_link" params:"run_link"`
@@ -1584,9 +1584,9 @@ type ModelVersionTag struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The tag key.
-	Key *string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" query:"key" params:"key"`
+	Key *string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" query:"key" params:"key" validate:"required,max=250,validMetricParamOrTagName,pathIsUnique"`
 	// The tag value.
-	Value *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty" query:"value" params:"value"`
+	Value *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty" query:"value" params:"value" validate:"omitempty,max=5000"`
 }
 
 func (x *ModelVersionTag) Reset() {
@@ -4188,7 +4188,7 @@ type SearchTraces struct {
 	// Example: “trace.status = 'OK' and trace.timestamp_ms > 1711089570679“.
 	Filter *string `protobuf:"bytes,2,opt,name=filter" json:"filter,omitempty" query:"filter" params:"filter"`
 	// Maximum number of traces desired. Max threshold is 500.
-	MaxResults *int32 `protobuf:"varint,3,opt,name=max_results,json=maxResults,def=100" json:"max_results,omitempty" query:"max_results" params:"max_results"`
+	MaxResults *int32 `protobuf:"varint,3,opt,name=max_results,json=maxResults,def=100" json:"max_results,omitempty" query:"max_results" params:"max_results" validate:"omitempty,gt=0,max=500"`
 	// List of columns for ordering the results, e.g. “["timestamp_ms DESC"]“.
 	OrderBy []string `protobuf:"bytes,4,rep,name=order_by,json=orderBy" json:"order_by,omitempty" query:"order_by" params:"order_by"`
 	// Token indicating the page of traces to fetch.
@@ -4279,7 +4279,7 @@ type SearchUnifiedTraces struct {
 	// Example: “trace.status = 'OK' and trace.timestamp_ms > 1711089570679“.
 	Filter *string `protobuf:"bytes,4,opt,name=filter" json:"filter,omitempty" query:"filter" params:"filter"`
 	// Maximum number of traces desired. Max threshold is 500.
-	MaxResults *int32 `protobuf:"varint,5,opt,name=max_results,json=maxResults,def=100" json:"max_results,omitempty" query:"max_results" params:"max_results"`
+	MaxResults *int32 `protobuf:"varint,5,opt,name=max_results,json=maxResults,def=100" json:"max_results,omitempty" query:"max_results" params:"max_results" validate:"omitempty,gt=0,max=500"`
 	// List of columns for ordering the results, e.g. “["timestamp_ms DESC"]“.
 	OrderBy []string `protobuf:"bytes,6,rep,name=order_by,json=orderBy" json:"order_by,omitempty" query:"order_by" params:"order_by"`
 	// Token indicating the page of traces to fetch. This is a unified token that encodes both online and offline traces
@@ -5311,7 +5311,7 @@ type CreateLoggedModel struct {
 	unknownFields protoimpl.UnknownFields
 
 	// ID of the associated experiment.
-	ExperimentId *string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId" json:"experiment_id,omitempty" query:"experiment_id" params:"experiment_id"`
+	ExperimentId *string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId" json:"experiment_id,omitempty" query:"experiment_id" params:"experiment_id" validate:"required,stringAsPositiveInteger"`
 	// Name of the model. Optional. If not specified, the backend will generate one.
 	Name *string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
 	// The type of model, such as "Agent", "Classifier", "LLM".
@@ -5319,9 +5319,9 @@ type CreateLoggedModel struct {
 	// Run ID of the run that created this model.
 	SourceRunId *string `protobuf:"bytes,4,opt,name=source_run_id,json=sourceRunId" json:"source_run_id,omitempty" query:"source_run_id" params:"source_run_id"`
 	// LoggedModel params.
-	Params []*LoggedModelParameter `protobuf:"bytes,5,rep,name=params" json:"params,omitempty" query:"params" params:"params"`
+	Params []*LoggedModelParameter `protobuf:"bytes,5,rep,name=params" json:"params,omitempty" query:"params" params:"params" validate:"omitempty,dive"`
 	// LoggedModel tags.
-	Tags []*LoggedModelTag `protobuf:"bytes,6,rep,name=tags" json:"tags,omitempty" query:"tags" params:"tags"`
+	Tags []*LoggedModelTag `protobuf:"bytes,6,rep,name=tags" json:"tags,omitempty" query:"tags" params:"tags" validate:"omitempty,dive"`
 }
 
 func (x *CreateLoggedModel) Reset() {
@@ -5407,7 +5407,7 @@ type FinalizeLoggedModel struct {
 	// Valid values in this message: ENUM<LOGGED_MODEL_READY, LOGGED_MODEL_UPLOAD_FAILED>
 	// ("LOGGED_MODEL_UPLOAD_FAILED" indicates that something went wrong when logging
 	// the model weights / agent code)
-	Status *LoggedModelStatus `protobuf:"varint,2,opt,name=status,enum=mlflow.LoggedModelStatus" json:"status,omitempty" query:"status" params:"status"`
+	Status *LoggedModelStatus `protobuf:"varint,2,opt,name=status,enum=mlflow.LoggedModelStatus" json:"status,omitempty" query:"status" params:"status" validate:"required"`
 }
 
 func (x *FinalizeLoggedModel) Reset() {
@@ -5564,7 +5564,7 @@ type SearchLoggedModels struct {
 	// If no datasets are specified, then metrics across all datasets are considered in the filter.
 	Datasets []*SearchLoggedModels_Dataset `protobuf:"bytes,6,rep,name=datasets" json:"datasets,omitempty" query:"datasets" params:"datasets"`
 	// Maximum number of Logged Models to return. Max threshold is 50.
-	MaxResults *int32 `protobuf:"varint,3,opt,name=max_results,json=maxResults,def=50" json:"max_results,omitempty" query:"max_results" params:"max_results"`
+	MaxResults *int32 `protobuf:"varint,3,opt,name=max_results,json=maxResults,def=50" json:"max_results,omitempty" query:"max_results" params:"max_results" validate:"omitempty,gt=0"`
 	// List of columns for ordering the results, with additional fields for sorting criteria.
 	OrderBy []*SearchLoggedModels_OrderBy `protobuf:"bytes,4,rep,name=order_by,json=orderBy" json:"order_by,omitempty" query:"order_by" params:"order_by"`
 	// Token indicating the page of Logged Models to fetch.
@@ -5656,7 +5656,7 @@ type SetLoggedModelTags struct {
 	// The ID of the LoggedModel to set the tag on.
 	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id"`
 	// The tag key.
-	Tags []*LoggedModelTag `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty" query:"tags" params:"tags"`
+	Tags []*LoggedModelTag `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty" query:"tags" params:"tags" validate:"omitempty,dive"`
 }
 
 func (x *SetLoggedModelTags) Reset() {
@@ -5830,7 +5830,7 @@ type LogLoggedModelParamsRequest struct {
 	// The ID of the logged model to log params for.
 	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id"`
 	// Parameters attached to the model.
-	Params []*LoggedModelParameter `protobuf:"bytes,2,rep,name=params" json:"params,omitempty" query:"params" params:"params"`
+	Params []*LoggedModelParameter `protobuf:"bytes,2,rep,name=params" json:"params,omitempty" query:"params" params:"params" validate:"omitempty,dive"`
 }
 
 func (x *LogLoggedModelParamsRequest) Reset() {
@@ -6098,9 +6098,9 @@ type LoggedModelTag struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The tag key.
-	Key *string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" query:"key" params:"key"`
+	Key *string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" query:"key" params:"key" validate:"required,max=250,validMetricParamOrTagName"`
 	// The tag value.
-	Value *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty" query:"value" params:"value"`
+	Value *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty" query:"value" params:"value" validate:"omitempty,max=8000"`
 }
 
 func (x *LoggedModelTag) Reset() {
@@ -6266,9 +6266,9 @@ type LoggedModelParameter struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Key identifying this param.
-	Key *string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" query:"key" params:"key"`
+	Key *string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" query:"key" params:"key" validate:"required,max=250,validMetricParamOrTagName"`
 	// Value associated with this param.
-	Value *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty" query:"value" params:"value"`
+	Value *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty" query:"value" params:"value" validate:"omitempty,max=6000"`
 }
 
 func (x *LoggedModelParameter) Reset() {
@@ -6327,7 +6327,7 @@ type SearchTracesV3 struct {
 	// Example: “trace.status = 'OK' and trace.timestamp_ms > 1711089570679“.
 	Filter *string `protobuf:"bytes,2,opt,name=filter" json:"filter,omitempty" query:"filter" params:"filter"`
 	// Maximum number of traces desired. Max threshold is 500.
-	MaxResults *int32 `protobuf:"varint,3,opt,name=max_results,json=maxResults,def=100" json:"max_results,omitempty" query:"max_results" params:"max_results"`
+	MaxResults *int32 `protobuf:"varint,3,opt,name=max_results,json=maxResults,def=100" json:"max_results,omitempty" query:"max_results" params:"max_results" validate:"omitempty,gt=0,max=500"`
 	// List of columns for ordering the results, e.g. “["timestamp_ms DESC"]“.
 	OrderBy []string `protobuf:"bytes,4,rep,name=order_by,json=orderBy" json:"order_by,omitempty" query:"order_by" params:"order_by"`
 	// Token indicating the page of traces to fetch.
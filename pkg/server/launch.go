@@ -14,6 +14,10 @@ import (
 )
 
 func Launch(ctx context.Context, cfg *config.Config) error {
+	if err := migrateDatabases(ctx, cfg); err != nil {
+		return err
+	}
+
 	if len(cfg.PythonCommand) > 0 {
 		return launchCommandAndServer(ctx, cfg)
 	}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// migrateDatabases checks, and upgrades when cfg.DatabaseUpgrade is set, the schema of the tracking
+// and model registry databases before the Go and Python servers use them. It also creates the tables
+// of the Go server in the tracking database whatever cfg.DatabaseUpgrade is set to. Failing to is only
+// a warning, the server then failing the requests which need them.
+func migrateDatabases(ctx context.Context, cfg *config.Config) error {
+	storeURIs := []string{cfg.TrackingStoreURI}
+	if cfg.ModelRegistryStoreURI != "" && cfg.ModelRegistryStoreURI != cfg.TrackingStoreURI {
+		storeURIs = append(storeURIs, cfg.ModelRegistryStoreURI)
+	}
+
+	// upgrading the schema of a large database may take longer than the statements of the requests.
+	options := cfg.Database
+	options.StatementTimeout = config.Duration{}
+
+	for _, storeURI := range storeURIs {
+		database, err := sql.NewDatabase(ctx, storeURI, options)
+		if err != nil {
+			return fmt.Errorf("failed to connect to database to migrate it: %w", err)
+		}
+
+		err = sql.Migrate(ctx, database, cfg.DatabaseUpgrade)
+		if err == nil && storeURI == cfg.TrackingStoreURI {
+			if tablesErr := sql.CreateServerTables(ctx, database); tablesErr != nil {
+				utils.GetLoggerFromContext(ctx).Warnf(
+					"The requests needing the tables of the Go server will fail: %v", tablesErr,
+				)
+			}
+		}
+
+		if err = errors.Join(err, sql.CloseDatabase(database)); err != nil {
+			return fmt.Errorf("failed to migrate database: %w", err)
+		}
+	}
+
+	return nil
+}
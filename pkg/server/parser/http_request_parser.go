@@ -69,6 +69,11 @@ func (p *HTTPRequestParser) ParseQuery(ctx *fiber.Ctx, input interface{}) *contr
 		return contract.NewError(protos.ErrorCode_BAD_REQUEST, err.Error())
 	}
 
+	// path parameters, e.g. `/mlflow/logged-models/:model_id`, are not part of the query string.
+	if err := ctx.ParamsParser(input); err != nil {
+		return contract.NewError(protos.ErrorCode_BAD_REQUEST, err.Error())
+	}
+
 	if err := p.validator.Struct(input); err != nil {
 		return validation.NewErrorFromValidationError(err)
 	}
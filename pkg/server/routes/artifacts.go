@@ -0,0 +1,327 @@
+package routes
+
+import (
+	"bytes"
+	"crypto/md5" //nolint:gosec // Content-MD5 is only an integrity check
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract/service"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos/artifacts"
+	"github.com/mlflow/mlflow-go-backend/pkg/server/parser"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+const (
+	// artifactPathRoute matches nested artifact paths, e.g. `/mlflow-artifacts/artifacts/1/abc/model/MLmodel`.
+	artifactPathRoute              = "/mlflow-artifacts/artifacts/+"
+	createMultipartUploadRoute     = "/mlflow-artifacts/mpu/create/+"
+	completeMultipartUploadRoute   = "/mlflow-artifacts/mpu/complete/+"
+	abortMultipartUploadRoute      = "/mlflow-artifacts/mpu/abort/+"
+	multipartUploadPartRoutePrefix = "/mlflow-artifacts/mpu/upload"
+	multipartUploadPartRoute       = multipartUploadPartRoutePrefix + "/:upload_id/:part_number"
+	// ContentMD5Header holds the base64 encoded MD5 digest of an uploaded part.
+	ContentMD5Header = "Content-MD5"
+	// ChecksumSHA256Header holds the base64 encoded SHA-256 digest of an uploaded part, like the
+	// header of the S3 API.
+	ChecksumSHA256Header = "X-Amz-Checksum-Sha256"
+)
+
+func artifactPathFromParams(ctx *fiber.Ctx) (string, *contract.Error) {
+	// the parameter points into the request buffer, which the next requests reuse.
+	artifactPath, err := url.PathUnescape(strings.Clone(ctx.Params("+")))
+	if err != nil {
+		return "", contract.NewError(protos.ErrorCode_BAD_REQUEST, err.Error())
+	}
+
+	return artifactPath, nil
+}
+
+// APIMountPrefixes are the prefixes the API is mounted on.
+var APIMountPrefixes = []string{"/api/2.0", "/ajax-api/2.0"}
+
+// artifactUploadReadAheadTimeout bounds the read of the start of the body of the artifact uploads,
+// which the server reads before handing the rest of it over as a stream.
+const artifactUploadReadAheadTimeout = 10 * time.Minute
+
+// isArtifactUpload tells whether the request to uri uploads artifact content, whose body is streamed
+// to the artifact repository rather than held in memory.
+func isArtifactUpload(method, uri []byte) bool {
+	if string(method) != fiber.MethodPut {
+		return false
+	}
+
+	path, _, _ := strings.Cut(string(uri), "?")
+
+	for _, prefix := range APIMountPrefixes {
+		if route, ok := strings.CutPrefix(path, prefix); ok {
+			return strings.HasPrefix(route, "/mlflow-artifacts/artifacts/") ||
+				strings.HasPrefix(route, multipartUploadPartRoutePrefix+"/")
+		}
+	}
+
+	return false
+}
+
+// limitRequestBody rejects the requests other than the artifact uploads with a body larger than
+// bodyLimit, which the server doesn't enforce on the request bodies it streams.
+func limitRequestBody(bodyLimit int) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		stream := ctx.Request().BodyStream()
+		if stream == nil || isArtifactUpload(ctx.Request().Header.Method(), ctx.Request().URI().Path()) {
+			return ctx.Next()
+		}
+
+		body, err := io.ReadAll(io.LimitReader(stream, int64(bodyLimit)+1))
+		if err != nil {
+			return fmt.Errorf("failed to read request body: %w", err)
+		}
+
+		if len(body) > bodyLimit {
+			// the rest of the body is left unread on the connection.
+			ctx.Context().SetConnectionClose()
+
+			return ctx.SendStatus(fiber.StatusRequestEntityTooLarge)
+		}
+
+		ctx.Request().SetBody(body)
+
+		return ctx.Next()
+	}
+}
+
+// StreamArtifactUploads sets up app, which streams the request bodies, for the artifact uploads to
+// take longer than its read timeout as long as they don't stall for as long, and for the other
+// requests to keep its body limit.
+func StreamArtifactUploads(app *fiber.App) {
+	app.Server().HeaderReceived = func(header *fasthttp.RequestHeader) fasthttp.RequestConfig {
+		if isArtifactUpload(header.Method(), header.RequestURI()) {
+			return fasthttp.RequestConfig{ReadTimeout: artifactUploadReadAheadTimeout}
+		}
+
+		return fasthttp.RequestConfig{}
+	}
+
+	app.Use(limitRequestBody(app.Config().BodyLimit))
+}
+
+// deadlineReader pushes back the read deadline of the connection before every read of the request
+// body, which the server only sets once before reading the headers. Slow uploads then only fail once
+// they stall for the read timeout, instead of once they take longer than it.
+type deadlineReader struct {
+	reader  io.Reader
+	conn    net.Conn
+	timeout time.Duration
+}
+
+func (r *deadlineReader) Read(p []byte) (int, error) {
+	if err := r.conn.SetReadDeadline(time.Now().Add(r.timeout)); err != nil {
+		return 0, fmt.Errorf("failed to set read deadline: %w", err)
+	}
+
+	return r.reader.Read(p) //nolint:wrapcheck
+}
+
+// requestBodyReader streams the request body when the server allows it and falls back to the buffered body.
+func requestBodyReader(ctx *fiber.Ctx) io.Reader {
+	reader := ctx.Request().BodyStream()
+	if reader == nil {
+		return bytes.NewReader(ctx.Body())
+	}
+
+	if timeout := ctx.App().Config().ReadTimeout; timeout > 0 {
+		return &deadlineReader{reader: reader, conn: ctx.Context().Conn(), timeout: timeout}
+	}
+
+	return reader
+}
+
+// checksumFromHeader decodes the base64 encoded digest of size bytes in the header, if the client set it.
+func checksumFromHeader(ctx *fiber.Ctx, header string, size int) ([]byte, *contract.Error) {
+	value := ctx.Get(header)
+	if value == "" {
+		return nil, nil
+	}
+
+	checksum, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || len(checksum) != size {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("%s must be a base64 encoded digest of %d bytes, got %q", header, size, value),
+		)
+	}
+
+	return checksum, nil
+}
+
+// partChecksumsFromHeaders returns the checksums a part must match, from its Content-MD5 and
+// X-Amz-Checksum-Sha256 headers.
+func partChecksumsFromHeaders(ctx *fiber.Ctx) (entities.MultipartUploadPartChecksums, *contract.Error) {
+	md5Checksum, err := checksumFromHeader(ctx, ContentMD5Header, md5.Size)
+	if err != nil {
+		return entities.MultipartUploadPartChecksums{}, err
+	}
+
+	sha256Checksum, err := checksumFromHeader(ctx, ChecksumSHA256Header, sha256.Size)
+	if err != nil {
+		return entities.MultipartUploadPartChecksums{}, err
+	}
+
+	return entities.MultipartUploadPartChecksums{MD5: md5Checksum, SHA256: sha256Checksum}, nil
+}
+
+// partUploadURL is the absolute URL parts of a multipart upload are sent to. It keeps the prefix
+// the API is mounted on, e.g. `/api/2.0` or `/ajax-api/2.0`.
+func partUploadURL(ctx *fiber.Ctx) string {
+	mountPrefix := strings.TrimSuffix(ctx.Route().Path, createMultipartUploadRoute)
+
+	return ctx.BaseURL() + mountPrefix + multipartUploadPartRoutePrefix
+}
+
+//nolint:funlen,cyclop
+func RegisterArtifactsContentRoutes(
+	service service.ArtifactsContentService, parser *parser.HTTPRequestParser, app *fiber.App,
+) {
+	app.Get(artifactPathRoute, func(ctx *fiber.Ctx) error {
+		artifactPath, err := artifactPathFromParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		reader, size, err := service.DownloadArtifact(utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath)
+		if err != nil {
+			return err
+		}
+
+		ctx.Attachment(path.Base(artifactPath))
+		ctx.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+
+		return ctx.SendStream(reader, int(size))
+	})
+	app.Put(artifactPathRoute, func(ctx *fiber.Ctx) error {
+		artifactPath, err := artifactPathFromParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = service.UploadArtifact(
+			utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath, requestBodyReader(ctx),
+		)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(&artifacts.UploadArtifact_Response{})
+	})
+	app.Delete(artifactPathRoute, func(ctx *fiber.Ctx) error {
+		artifactPath, err := artifactPathFromParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := service.DeleteArtifact(utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath); err != nil {
+			return err
+		}
+
+		return ctx.JSON(&artifacts.DeleteArtifact_Response{})
+	})
+	app.Post(createMultipartUploadRoute, func(ctx *fiber.Ctx) error {
+		artifactPath, err := artifactPathFromParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		input := &artifacts.CreateMultipartUpload{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+
+		output, err := service.CreateMultipartUpload(
+			utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath, input, partUploadURL(ctx),
+		)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(output)
+	})
+	app.Put(multipartUploadPartRoute, func(ctx *fiber.Ctx) error {
+		partNumber, parseErr := strconv.ParseInt(ctx.Params("part_number"), 10, 64)
+		if parseErr != nil {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid part number: %q", ctx.Params("part_number")),
+			)
+		}
+
+		checksums, err := partChecksumsFromHeaders(ctx)
+		if err != nil {
+			return err
+		}
+
+		etag, err := service.UploadMultipartUploadPart(
+			utils.NewContextWithLoggerFromFiberContext(ctx),
+			ctx.Params("upload_id"),
+			partNumber,
+			requestBodyReader(ctx),
+			checksums,
+		)
+		if err != nil {
+			return err
+		}
+
+		ctx.Set(fiber.HeaderETag, strconv.Quote(etag))
+
+		return ctx.JSON(fiber.Map{})
+	})
+	app.Post(completeMultipartUploadRoute, func(ctx *fiber.Ctx) error {
+		artifactPath, err := artifactPathFromParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		input := &artifacts.CompleteMultipartUpload{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+
+		output, err := service.CompleteMultipartUpload(utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath, input)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(output)
+	})
+	app.Post(abortMultipartUploadRoute, func(ctx *fiber.Ctx) error {
+		artifactPath, err := artifactPathFromParams(ctx)
+		if err != nil {
+			return err
+		}
+
+		input := &artifacts.AbortMultipartUpload{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+
+		output, err := service.AbortMultipartUpload(utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath, input)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(output)
+	})
+}
@@ -0,0 +1,284 @@
+package routes_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract/service"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/server/parser"
+	"github.com/mlflow/mlflow-go-backend/pkg/server/routes"
+)
+
+const (
+	testBodyLimit   = 1024
+	testReadTimeout = 200 * time.Millisecond
+)
+
+// artifactsContentService records the uploaded artifacts in memory.
+type artifactsContentService struct {
+	service.ArtifactsContentService
+
+	mutex     sync.Mutex
+	uploaded  map[string][]byte
+	checksums map[int64]entities.MultipartUploadPartChecksums
+}
+
+func (s *artifactsContentService) UploadArtifact(_ context.Context, path string, reader io.Reader) *contract.Error {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to read artifact", err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.uploaded[path] = content
+
+	return nil
+}
+
+func (s *artifactsContentService) UploadMultipartUploadPart(
+	_ context.Context, _ string, partNumber int64, _ io.Reader, checksums entities.MultipartUploadPartChecksums,
+) (string, *contract.Error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.checksums[partNumber] = checksums
+
+	return "etag", nil
+}
+
+// newArtifactsServer serves the artifact routes like the Go server, with a short read timeout.
+func newArtifactsServer(t *testing.T) (string, *artifactsContentService) {
+	t.Helper()
+
+	app := fiber.New(fiber.Config{
+		BodyLimit:                    testBodyLimit,
+		StreamRequestBody:            true,
+		DisablePreParseMultipartForm: true,
+		ReadTimeout:                  testReadTimeout,
+		DisableStartupMessage:        true,
+	})
+	routes.StreamArtifactUploads(app)
+
+	requestParser, err := parser.NewHTTPRequestParser()
+	require.NoError(t, err)
+
+	service := &artifactsContentService{
+		uploaded:  make(map[string][]byte),
+		checksums: make(map[int64]entities.MultipartUploadPartChecksums),
+	}
+
+	apiApp := fiber.New(fiber.Config{
+		ErrorHandler: func(ctx *fiber.Ctx, err error) error {
+			var contractError *contract.Error
+			if errors.As(err, &contractError) {
+				return ctx.Status(contractError.StatusCode()).JSON(contractError)
+			}
+
+			return ctx.Status(fiber.StatusInternalServerError).SendString(err.Error())
+		},
+	})
+	routes.RegisterArtifactsContentRoutes(service, requestParser, apiApp)
+	apiApp.Post("/mlflow/runs/search", func(ctx *fiber.Ctx) error {
+		return ctx.Send(ctx.Body())
+	})
+	app.Mount("/api/2.0", apiApp)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() {
+		_ = app.Listener(listener)
+	}()
+
+	t.Cleanup(func() {
+		require.NoError(t, app.Shutdown())
+	})
+
+	return listener.Addr().String(), service
+}
+
+func TestSlowArtifactUpload(t *testing.T) {
+	t.Parallel()
+
+	address, service := newArtifactsServer(t)
+
+	conn, err := net.Dial("tcp", address)
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	// the upload takes 8 times the read timeout, but never stalls for as long. The server reads the
+	// first 8KB ahead of the handler, which streams the rest.
+	content := bytes.Repeat([]byte("0123456789abcdef"), 1024)
+	_, err = fmt.Fprintf(
+		conn, "PUT /api/2.0/mlflow-artifacts/artifacts/model.bin HTTP/1.1\r\nHost: %s\r\nContent-Length: %d\r\n\r\n",
+		address, len(content),
+	)
+	require.NoError(t, err)
+
+	for chunk := range slices.Chunk(content, len(content)/16) {
+		time.Sleep(testReadTimeout / 2)
+
+		_, err = conn.Write(chunk)
+		require.NoError(t, err)
+	}
+
+	response, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+
+	assert.Equal(t, content, service.uploaded["model.bin"])
+}
+
+func TestStalledArtifactUpload(t *testing.T) {
+	t.Parallel()
+
+	address, _ := newArtifactsServer(t)
+
+	conn, err := net.Dial("tcp", address)
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	// the upload stops after the part the server reads ahead of the handler.
+	_, err = fmt.Fprintf(
+		conn, "PUT /api/2.0/mlflow-artifacts/artifacts/model.bin HTTP/1.1\r\nHost: %s\r\nContent-Length: %d\r\n\r\n%s",
+		address, 32*1024, bytes.Repeat([]byte("a"), 16*1024),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(10*testReadTimeout)))
+
+	response, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+	assert.Equal(t, http.StatusInternalServerError, response.StatusCode)
+}
+
+func TestLimitRequestBody(t *testing.T) {
+	t.Parallel()
+
+	address, service := newArtifactsServer(t)
+
+	for _, test := range []struct {
+		method string
+		path   string
+		size   int
+		status int
+	}{
+		{http.MethodPost, "/mlflow/runs/search", testBodyLimit, http.StatusOK},
+		{http.MethodPost, "/mlflow/runs/search", testBodyLimit + 1, http.StatusRequestEntityTooLarge},
+		{http.MethodPut, "/mlflow-artifacts/artifacts/large.bin", 10 * testBodyLimit, http.StatusOK},
+		// only the paths of the uploads lift the limit.
+		{http.MethodPut, "/mlflow/mlflow-artifacts/artifacts/large.bin", 2 * testBodyLimit, http.StatusRequestEntityTooLarge},
+		{
+			http.MethodPut, "/mlflow/runs/update?path=/mlflow-artifacts/artifacts/", 2 * testBodyLimit,
+			http.StatusRequestEntityTooLarge,
+		},
+	} {
+		request, err := http.NewRequestWithContext(
+			context.Background(), test.method, "http://"+address+"/api/2.0"+test.path,
+			bytes.NewReader(bytes.Repeat([]byte("a"), test.size)),
+		)
+		require.NoError(t, err)
+
+		response, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+
+		assert.Equal(t, test.status, response.StatusCode, test.path)
+
+		if test.status == http.StatusOK && test.method == http.MethodPost {
+			assert.Len(t, body, test.size)
+		}
+	}
+
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+
+	assert.Len(t, service.uploaded["large.bin"], 10*testBodyLimit)
+}
+
+func TestUploadPartChecksumHeaders(t *testing.T) {
+	t.Parallel()
+
+	address, service := newArtifactsServer(t)
+
+	md5Checksum := md5.Sum([]byte("part")) //nolint:gosec
+	sha256Checksum := sha256.Sum256([]byte("part"))
+
+	for partNumber, test := range map[int64]struct {
+		headers map[string]string
+		status  int
+	}{
+		1: {nil, http.StatusOK},
+		2: {
+			map[string]string{
+				routes.ContentMD5Header:     base64.StdEncoding.EncodeToString(md5Checksum[:]),
+				routes.ChecksumSHA256Header: base64.StdEncoding.EncodeToString(sha256Checksum[:]),
+			},
+			http.StatusOK,
+		},
+		3: {map[string]string{routes.ContentMD5Header: "not base64"}, http.StatusBadRequest},
+		// the digest is too short for a SHA-256 one.
+		4: {
+			map[string]string{routes.ChecksumSHA256Header: base64.StdEncoding.EncodeToString(md5Checksum[:])},
+			http.StatusBadRequest,
+		},
+	} {
+		request, err := http.NewRequestWithContext(
+			context.Background(),
+			http.MethodPut,
+			fmt.Sprintf("http://%s/api/2.0/mlflow-artifacts/mpu/upload/%032d/%d", address, 0, partNumber),
+			strings.NewReader("part"),
+		)
+		require.NoError(t, err)
+
+		for header, value := range test.headers {
+			request.Header.Set(header, value)
+		}
+
+		response, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+		assert.Equal(t, test.status, response.StatusCode, partNumber)
+	}
+
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+
+	assert.Equal(t, map[int64]entities.MultipartUploadPartChecksums{
+		1: {},
+		2: {MD5: md5Checksum[:], SHA256: sha256Checksum[:]},
+	}, service.checksums)
+}
@@ -0,0 +1,93 @@
+package routes
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract/service"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/server/parser"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// explainRoutePrefix prefixes the route of the explained search, e.g. `/mlflow/admin/explain/runs/search`
+// explains `/mlflow/runs/search`, which takes the same request.
+const explainRoutePrefix = "/mlflow/admin/explain"
+
+// requireAdminToken rejects the requests without `Authorization: Bearer <adminToken>`.
+func requireAdminToken(adminToken string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		token, ok := strings.CutPrefix(ctx.Get(fiber.HeaderAuthorization), "Bearer ")
+		if !ok {
+			return contract.NewError(protos.ErrorCode_UNAUTHENTICATED, "Missing admin bearer token")
+		}
+
+		if subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+			return contract.NewError(protos.ErrorCode_PERMISSION_DENIED, "Invalid admin bearer token")
+		}
+
+		return ctx.Next()
+	}
+}
+
+func RegisterSearchExplainRoutes(
+	service service.SearchExplainService, parser *parser.HTTPRequestParser, adminToken string, app *fiber.App,
+) {
+	admin := app.Group(explainRoutePrefix, requireAdminToken(adminToken))
+
+	admin.Post("/runs/search", func(ctx *fiber.Ctx) error {
+		input := &protos.SearchRuns{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+
+		output, err := service.ExplainSearchRuns(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(output)
+	})
+	admin.Get("/experiments/search", func(ctx *fiber.Ctx) error {
+		input := &protos.SearchExperiments{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+
+		output, err := service.ExplainSearchExperiments(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(output)
+	})
+	admin.Post("/experiments/search", func(ctx *fiber.Ctx) error {
+		input := &protos.SearchExperiments{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+
+		output, err := service.ExplainSearchExperiments(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(output)
+	})
+	admin.Get("/traces", func(ctx *fiber.Ctx) error {
+		input := &protos.SearchTraces{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+
+		output, err := service.ExplainSearchTraces(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(output)
+	})
+}
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+)
+
+// FallThrough passes the requests the routes fail to serve with contract.ErrFallThrough, e.g.
+// the listing of artifacts in a store only the Python server can reach, on to handler.
+func FallThrough(handler fiber.Handler) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		err := ctx.Next()
+		if errors.Is(err, contract.ErrFallThrough) {
+			return handler(ctx)
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,56 @@
+package routes_test
+
+import (
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/server/routes"
+)
+
+func TestFallThrough(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	app.Use(routes.FallThrough(func(ctx *fiber.Ctx) error {
+		return ctx.SendString("python")
+	}))
+
+	apiApp := fiber.New()
+	apiApp.Get("/unsupported", func(*fiber.Ctx) error {
+		return contract.NewErrorWith(
+			protos.ErrorCode_NOT_IMPLEMENTED, "not supported", fmt.Errorf("%w: gs://bucket", contract.ErrFallThrough),
+		)
+	})
+	apiApp.Get("/failed", func(*fiber.Ctx) error {
+		return contract.NewError(protos.ErrorCode_INTERNAL_ERROR, "failed")
+	})
+	apiApp.Get("/served", func(ctx *fiber.Ctx) error {
+		return ctx.SendString("go")
+	})
+	app.Mount("/api/2.0", apiApp)
+
+	for path, expected := range map[string]string{
+		"/api/2.0/unsupported": "python",
+		"/api/2.0/served":      "go",
+	} {
+		response, err := app.Test(httptest.NewRequest(fiber.MethodGet, path, nil))
+		require.NoError(t, err, path)
+
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err, path)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode, path)
+		assert.Equal(t, expected, string(body), path)
+	}
+
+	response, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/api/2.0/failed", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusInternalServerError, response.StatusCode)
+}
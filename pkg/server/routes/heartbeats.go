@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"encoding/json"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract/service"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// runHeartbeatRequest is the body of the heartbeat endpoint, which has no MLflow proto.
+type runHeartbeatRequest struct {
+	RunID string `json:"run_id"`
+}
+
+func RegisterRunHeartbeatRoutes(service service.RunHeartbeatService, app *fiber.App) {
+	app.Post("/mlflow/runs/heartbeat", func(ctx *fiber.Ctx) error {
+		var input runHeartbeatRequest
+		if err := json.Unmarshal(ctx.Body(), &input); err != nil {
+			return contract.NewError(protos.ErrorCode_BAD_REQUEST, err.Error())
+		}
+
+		if err := service.RecordRunHeartbeat(utils.NewContextWithLoggerFromFiberContext(ctx), input.RunID); err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{})
+	})
+}
@@ -0,0 +1,139 @@
+package routes
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract/service"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+const (
+	// IdempotencyKeyHeader holds a key unique to a request, which its retries are made with too.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on the responses replayed for an idempotency key.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+)
+
+// idempotentRoutePrefixes are the prefixes of the tracking routes honoring the Idempotency-Key header.
+var idempotentRoutePrefixes = []string{"/mlflow/experiments", "/mlflow/runs", "/mlflow/traces", "/mlflow/logged-models"}
+
+// readOnlyRoutes are the tracking routes served with POST for their request body, which change
+// nothing, so their requests are processed again rather than claiming a key.
+var readOnlyRoutes = []string{
+	"/mlflow/experiments/search",
+	"/mlflow/experiments/search-datasets",
+	"/mlflow/runs/search",
+	"/mlflow/traces/search",
+	"/mlflow/logged-models/search",
+}
+
+// isMutatingRequest tells whether the request may change the tracking store, the API being mounted
+// under several prefixes.
+func isMutatingRequest(ctx *fiber.Ctx) bool {
+	if ctx.Method() == fiber.MethodGet {
+		return false
+	}
+
+	path := strings.TrimSuffix(ctx.Path(), "/")
+
+	return !slices.ContainsFunc(readOnlyRoutes, func(route string) bool {
+		return strings.HasSuffix(path, route)
+	})
+}
+
+// hashRequest identifies a request by its method, path and body, for a key to be reused only
+// for the same request.
+func hashRequest(ctx *fiber.Ctx) string {
+	hash := sha256.New()
+	hash.Write([]byte(ctx.Method() + " " + ctx.Path() + "\n"))
+	hash.Write(ctx.Body())
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// answerIdempotentRequest processes the request claiming key, and records its response unless it is
+// a server error, which releases the key for the request to be retried. The key is released too when
+// the response cannot be recorded, the request having been processed all the same.
+func answerIdempotentRequest(ctx *fiber.Ctx, service service.IdempotencyService, key string) error {
+	if err := ctx.Next(); err != nil {
+		if err := ctx.App().ErrorHandler(ctx, err); err != nil {
+			return err
+		}
+	}
+
+	userContext := utils.NewContextWithLoggerFromFiberContext(ctx)
+
+	status := ctx.Response().StatusCode()
+	if status >= fiber.StatusInternalServerError {
+		if err := service.ReleaseIdempotencyKey(userContext, key); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err := service.SaveIdempotentResponse(userContext, key, status, ctx.Response().Body()); err != nil {
+		logger := utils.GetLoggerFromContext(userContext)
+		logger.Warnf("Failed to record the response for %s %q: %v", IdempotencyKeyHeader, key, err)
+
+		if err := service.ReleaseIdempotencyKey(userContext, key); err != nil {
+			logger.Errorf("Failed to release %s %q: %v", IdempotencyKeyHeader, key, err)
+		}
+	}
+
+	return nil
+}
+
+// RegisterIdempotencyKeys makes the mutating tracking routes answer the requests retried with the same
+// Idempotency-Key header with the response to the first one, instead of processing them again.
+func RegisterIdempotencyKeys(service service.IdempotencyService, app *fiber.App) {
+	app.Use(idempotentRoutePrefixes, func(ctx *fiber.Ctx) error {
+		key := ctx.Get(IdempotencyKeyHeader)
+		if key == "" || !isMutatingRequest(ctx) {
+			return ctx.Next()
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("%s must be at most %d characters long", IdempotencyKeyHeader, maxIdempotencyKeyLength),
+			)
+		}
+
+		requestHash := hashRequest(ctx)
+
+		response, err := service.ClaimIdempotencyKey(utils.NewContextWithLoggerFromFiberContext(ctx), key, requestHash)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case response == nil:
+			return answerIdempotentRequest(ctx, service, key)
+		case response.RequestHash != requestHash:
+			return contract.NewError(
+				protos.ErrorCode_RESOURCE_CONFLICT,
+				fmt.Sprintf("%s %q was already used for a different request", IdempotencyKeyHeader, key),
+			)
+		case response.Status == 0:
+			return contract.NewError(
+				protos.ErrorCode_RESOURCE_CONFLICT,
+				fmt.Sprintf("The request with %s %q is still being processed", IdempotencyKeyHeader, key),
+			)
+		}
+
+		ctx.Set(IdempotentReplayedHeader, "true")
+		ctx.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		return ctx.Status(response.Status).Send(response.Body)
+	})
+}
@@ -0,0 +1,181 @@
+package routes_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/server/routes"
+)
+
+// idempotencyService records the responses in memory, or fails to when failSaves is set.
+type idempotencyService struct {
+	mutex     sync.Mutex
+	responses map[string]*entities.IdempotentResponse
+	failSaves bool
+}
+
+func (s *idempotencyService) ClaimIdempotencyKey(
+	_ context.Context, key, requestHash string,
+) (*entities.IdempotentResponse, *contract.Error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if response, ok := s.responses[key]; ok {
+		return response, nil
+	}
+
+	s.responses[key] = &entities.IdempotentResponse{RequestHash: requestHash}
+
+	return nil, nil //nolint:nilnil
+}
+
+func (s *idempotencyService) SaveIdempotentResponse(
+	_ context.Context, key string, status int, body []byte,
+) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.failSaves {
+		return contract.NewError(protos.ErrorCode_INTERNAL_ERROR, "failed to save")
+	}
+
+	s.responses[key].Status = status
+	s.responses[key].Body = body
+
+	return nil
+}
+
+func (s *idempotencyService) ReleaseIdempotencyKey(_ context.Context, key string) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.responses, key)
+
+	return nil
+}
+
+func TestIdempotencyKeys(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New(fiber.Config{
+		ErrorHandler: func(ctx *fiber.Ctx, err error) error {
+			var contractError *contract.Error
+			if errors.As(err, &contractError) {
+				return ctx.Status(contractError.StatusCode()).JSON(contractError)
+			}
+
+			return ctx.Status(fiber.StatusInternalServerError).SendString(err.Error())
+		},
+	})
+	routes.RegisterIdempotencyKeys(&idempotencyService{responses: make(map[string]*entities.IdempotentResponse)}, app)
+
+	created := 0
+	app.Post("/mlflow/runs/create", func(ctx *fiber.Ctx) error {
+		if strings.Contains(string(ctx.Body()), "fail") {
+			return contract.NewError(protos.ErrorCode_INTERNAL_ERROR, "failed")
+		}
+
+		created++
+
+		return ctx.JSON(fiber.Map{"run_id": created})
+	})
+
+	searched := 0
+	app.Post("/mlflow/runs/search", func(ctx *fiber.Ctx) error {
+		searched++
+
+		return ctx.JSON(fiber.Map{"searches": searched})
+	})
+
+	for _, test := range []struct {
+		name     string
+		path     string
+		key      string
+		body     string
+		status   int
+		response string
+		replayed bool
+	}{
+		{name: "first request", key: "1", body: "{}", status: 200, response: `{"run_id":1}`},
+		{name: "retry", key: "1", body: "{}", status: 200, response: `{"run_id":1}`, replayed: true},
+		{name: "different request", key: "1", body: `{"run_name":"run"}`, status: 409},
+		{name: "no key", body: "{}", status: 200, response: `{"run_id":2}`},
+		{name: "server error", key: "2", body: `"fail"`, status: 500},
+		{name: "retry after server error", key: "2", body: "{}", status: 200, response: `{"run_id":3}`},
+		// the read-only routes are processed again with the same key.
+		{name: "search", path: "/mlflow/runs/search", key: "3", body: "{}", status: 200, response: `{"searches":1}`},
+		{name: "search again", path: "/mlflow/runs/search", key: "3", body: "{}", status: 200, response: `{"searches":2}`},
+	} {
+		path := test.path
+		if path == "" {
+			path = "/mlflow/runs/create"
+		}
+
+		request := httptest.NewRequest(http.MethodPost, path, strings.NewReader(test.body))
+		if test.key != "" {
+			request.Header.Set(routes.IdempotencyKeyHeader, test.key)
+		}
+
+		response, err := app.Test(request)
+		require.NoError(t, err, test.name)
+
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err, test.name)
+		require.NoError(t, response.Body.Close())
+
+		assert.Equal(t, test.status, response.StatusCode, test.name)
+
+		if test.response != "" {
+			assert.Equal(t, test.response, string(body), test.name)
+		}
+
+		assert.Equal(t, test.replayed, response.Header.Get(routes.IdempotentReplayedHeader) == "true", test.name)
+	}
+}
+
+func TestIdempotencyKeysUnsavedResponse(t *testing.T) {
+	t.Parallel()
+
+	service := &idempotencyService{responses: make(map[string]*entities.IdempotentResponse), failSaves: true}
+
+	app := fiber.New()
+	routes.RegisterIdempotencyKeys(service, app)
+
+	created := 0
+	app.Post("/mlflow/runs/create", func(ctx *fiber.Ctx) error {
+		created++
+
+		return ctx.JSON(fiber.Map{"run_id": created})
+	})
+
+	// the response the key could not be recorded for is still sent, and the key released.
+	for run := 1; run <= 2; run++ {
+		request := httptest.NewRequest(http.MethodPost, "/mlflow/runs/create", strings.NewReader("{}"))
+		request.Header.Set(routes.IdempotencyKeyHeader, "1")
+
+		response, err := app.Test(request)
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+		assert.Equal(t, fmt.Sprintf(`{"run_id":%d}`, run), string(body))
+		assert.Empty(t, service.responses)
+	}
+}
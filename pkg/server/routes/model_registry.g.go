@@ -72,6 +72,17 @@ func RegisterModelRegistryServiceRoutes(service service.ModelRegistryService, pa
 		}
 		return ctx.JSON(output)
 	})
+	app.Get("/mlflow/registered-models/search", func(ctx *fiber.Ctx) error {
+		input := &protos.SearchRegisteredModels{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.SearchRegisteredModels(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 	app.Post("/mlflow/registered-models/get-latest-versions", func(ctx *fiber.Ctx) error {
 		input := &protos.GetLatestVersions{}
 		if err := parser.ParseBody(ctx, input); err != nil {
@@ -94,6 +105,17 @@ func RegisterModelRegistryServiceRoutes(service service.ModelRegistryService, pa
 		}
 		return ctx.JSON(output)
 	})
+	app.Post("/mlflow/model-versions/create", func(ctx *fiber.Ctx) error {
+		input := &protos.CreateModelVersion{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.CreateModelVersion(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 	app.Patch("/mlflow/model-versions/update", func(ctx *fiber.Ctx) error {
 		input := &protos.UpdateModelVersion{}
 		if err := parser.ParseBody(ctx, input); err != nil {
@@ -138,6 +160,17 @@ func RegisterModelRegistryServiceRoutes(service service.ModelRegistryService, pa
 		}
 		return ctx.JSON(output)
 	})
+	app.Get("/mlflow/model-versions/search", func(ctx *fiber.Ctx) error {
+		input := &protos.SearchModelVersions{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.SearchModelVersions(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 	app.Get("/mlflow/model-versions/get-download-uri", func(ctx *fiber.Ctx) error {
 		input := &protos.GetModelVersionDownloadUri{}
 		if err := parser.ParseQuery(ctx, input); err != nil {
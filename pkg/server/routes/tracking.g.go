@@ -247,6 +247,17 @@ func RegisterTrackingServiceRoutes(service service.TrackingService, parser *pars
 		}
 		return ctx.JSON(output)
 	})
+	app.Get("/mlflow/artifacts/list", func(ctx *fiber.Ctx) error {
+		input := &protos.ListArtifacts{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.ListArtifacts(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 	app.Get("/mlflow/metrics/get-history", func(ctx *fiber.Ctx) error {
 		input := &protos.GetMetricHistory{}
 		if err := parser.ParseQuery(ctx, input); err != nil {
@@ -258,6 +269,17 @@ func RegisterTrackingServiceRoutes(service service.TrackingService, parser *pars
 		}
 		return ctx.JSON(output)
 	})
+	app.Get("/mlflow/metrics/get-history-bulk-interval", func(ctx *fiber.Ctx) error {
+		input := &protos.GetMetricHistoryBulkInterval{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.GetMetricHistoryBulkInterval(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 	app.Post("/mlflow/runs/log-batch", func(ctx *fiber.Ctx) error {
 		input := &protos.LogBatch{}
 		if err := parser.ParseBody(ctx, input); err != nil {
@@ -324,6 +346,28 @@ func RegisterTrackingServiceRoutes(service service.TrackingService, parser *pars
 		}
 		return ctx.JSON(output)
 	})
+	app.Get("/mlflow/traces", func(ctx *fiber.Ctx) error {
+		input := &protos.SearchTraces{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.SearchTraces(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow/traces/search", func(ctx *fiber.Ctx) error {
+		input := &protos.SearchTracesV3{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.SearchTracesV3(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 	app.Post("/mlflow/traces", func(ctx *fiber.Ctx) error {
 		input := &protos.StartTraceV3{}
 		if err := parser.ParseBody(ctx, input); err != nil {
@@ -335,6 +379,17 @@ func RegisterTrackingServiceRoutes(service service.TrackingService, parser *pars
 		}
 		return ctx.JSON(output)
 	})
+	app.Get("/mlflow/unified-traces", func(ctx *fiber.Ctx) error {
+		input := &protos.SearchUnifiedTraces{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.SearchUnifiedTraceHandler(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 	app.Post("/mlflow/traces/delete-traces", func(ctx *fiber.Ctx) error {
 		input := &protos.DeleteTraces{}
 		if err := parser.ParseBody(ctx, input); err != nil {
@@ -346,4 +401,92 @@ func RegisterTrackingServiceRoutes(service service.TrackingService, parser *pars
 		}
 		return ctx.JSON(output)
 	})
+	app.Post("/mlflow/logged-models", func(ctx *fiber.Ctx) error {
+		input := &protos.CreateLoggedModel{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.CreateLoggedModel(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Patch("/mlflow/logged-models/:model_id", func(ctx *fiber.Ctx) error {
+		input := &protos.FinalizeLoggedModel{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.FinalizeLoggedModel(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Get("/mlflow/logged-models/:model_id", func(ctx *fiber.Ctx) error {
+		input := &protos.GetLoggedModel{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.GetLoggedModel(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Delete("/mlflow/logged-models/:model_id", func(ctx *fiber.Ctx) error {
+		input := &protos.DeleteLoggedModel{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.DeleteLoggedModel(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow/logged-models/search", func(ctx *fiber.Ctx) error {
+		input := &protos.SearchLoggedModels{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.SearchLoggedModels(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Patch("/mlflow/logged-models/:model_id/tags", func(ctx *fiber.Ctx) error {
+		input := &protos.SetLoggedModelTags{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.SetLoggedModelTags(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Delete("/mlflow/logged-models/:model_id/tags/:tag_key", func(ctx *fiber.Ctx) error {
+		input := &protos.DeleteLoggedModelTag{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.DeleteLoggedModelTag(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow/logged-models/:model_id/params", func(ctx *fiber.Ctx) error {
+		input := &protos.LogLoggedModelParamsRequest{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.LogLoggedModelParams(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 }
@@ -36,10 +36,15 @@ func configureApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
 	app := fiber.New(fiber.Config{
 		BodyLimit:      16 * 1024 * 1024,
 		ReadBufferSize: 16384,
-		ReadTimeout:    5 * time.Second,
-		WriteTimeout:   600 * time.Second,
-		IdleTimeout:    120 * time.Second,
-		ServerHeader:   "mlflow/" + cfg.Version,
+		// artifact uploads are streamed to the artifact repository instead of being buffered in memory.
+		// The server can only stream the bodies of all the requests, so routes.StreamArtifactUploads
+		// enforces the body limit of the other requests, whose multipart forms must not be read ahead.
+		StreamRequestBody:            true,
+		DisablePreParseMultipartForm: true,
+		ReadTimeout:                  5 * time.Second,
+		WriteTimeout:                 600 * time.Second,
+		IdleTimeout:                  120 * time.Second,
+		ServerHeader:                 "mlflow/" + cfg.Version,
 		JSONEncoder: func(value interface{}) ([]byte, error) {
 			if protoMessage, ok := value.(proto.Message); ok {
 				return protojson.MarshalOptions{
@@ -67,6 +72,7 @@ func configureApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
 
 	app.Use(compress.New())
 	app.Use(recover.New(recover.Config{EnableStackTrace: true}))
+	routes.StreamArtifactUploads(app)
 	app.Use(logger.New(logger.Config{
 		Format: "${status} - ${latency} ${method} ${path}\n",
 		Output: utils.GetLoggerFromContext(ctx).Writer(),
@@ -77,21 +83,34 @@ func configureApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
 		return c.Next()
 	})
 
-	apiApp, err := newAPIApp(ctx, cfg)
+	var forwardToPython fiber.Handler
+	if cfg.PythonAddress != "" {
+		forwardToPython = proxy.BalancerForward([]string{cfg.PythonAddress})
+		app.Use(routes.FallThrough(forwardToPython))
+	}
+
+	apiApp, services, err := newAPIApp(ctx, cfg)
 	if err != nil {
 		return nil, err
 	}
 
+	// the services are destroyed once the server stops serving requests, writing what they buffered.
+	app.Hooks().OnShutdown(func() error {
+		errs := make([]error, 0, len(services))
+		for _, service := range services {
+			errs = append(errs, service.Destroy())
+		}
+
+		return errors.Join(errs...)
+	})
+
 	app.All("/graphql", func(c *fiber.Ctx) error {
 		return proxy.Do(c, "http://127.0.0.1:5001/graphql")
 	})
 
-	app.Mount("/api/2.0", apiApp)
-	app.Mount("/ajax-api/2.0", apiApp)
-
-	app.All("/ajax-api/2.0/mlflow/logged-models/search", func(c *fiber.Ctx) error {
-		return proxy.Do(c, "http://127.0.0.1:5001/ajax-api/2.0/mlflow/logged-models/search")
-	})
+	for _, prefix := range routes.APIMountPrefixes {
+		app.Mount(prefix, apiApp)
+	}
 
 	if cfg.StaticFolder != "" {
 		app.Static("/static-files", cfg.StaticFolder)
@@ -107,8 +126,8 @@ func configureApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
 		return c.SendString(cfg.Version)
 	})
 
-	if cfg.PythonAddress != "" {
-		app.Use(proxy.BalancerForward([]string{cfg.PythonAddress}))
+	if forwardToPython != nil {
+		app.Use(forwardToPython)
 	}
 
 	return app, nil
@@ -122,7 +141,11 @@ func launchServer(ctx context.Context, cfg *config.Config) error {
 		return err
 	}
 
+	shutdown := make(chan struct{})
+
 	go func() {
+		defer close(shutdown)
+
 		<-ctx.Done()
 
 		logger.Info("Shutting down MLflow Go server")
@@ -162,6 +185,9 @@ func launchServer(ctx context.Context, cfg *config.Config) error {
 		return fmt.Errorf("failed to start MLflow Go server: %w", err)
 	}
 
+	// Listen returns as soon as the shutdown starts, before the services are destroyed.
+	<-shutdown
+
 	return nil
 }
 
@@ -208,34 +234,50 @@ func newFiberConfig() fiber.Config {
 	}
 }
 
-func newAPIApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
+func newAPIApp(ctx context.Context, cfg *config.Config) (*fiber.App, []contract.Destroyer, error) {
 	app := fiber.New(newFiberConfig())
 
 	parser, err := parser.NewHTTPRequestParser()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create new HTTP request parser: %w", err)
+		return nil, nil, fmt.Errorf("failed to create new HTTP request parser: %w", err)
 	}
 
 	trackingService, err := ts.NewTrackingService(ctx, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create new tracking service: %w", err)
+		return nil, nil, fmt.Errorf("failed to create new tracking service: %w", err)
 	}
 
+	// the middleware must be registered before the routes it answers retried requests of.
+	routes.RegisterIdempotencyKeys(trackingService, app)
 	routes.RegisterTrackingServiceRoutes(trackingService, parser, app)
+	routes.RegisterRunHeartbeatRoutes(trackingService, app)
+
+	// the admin endpoints are only served when they are protected by a token.
+	if cfg.AdminToken != "" {
+		routes.RegisterSearchExplainRoutes(trackingService, parser, cfg.AdminToken, app)
+	}
 
 	modelRegistryService, err := mr.NewModelRegistryService(ctx, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create new model registry service: %w", err)
+		return nil, nil, fmt.Errorf("failed to create new model registry service: %w", err)
 	}
 
 	routes.RegisterModelRegistryServiceRoutes(modelRegistryService, parser, app)
 
 	artifactService, err := as.NewArtifactsService(ctx, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create new artifacts service: %w", err)
+		return nil, nil, fmt.Errorf("failed to create new artifacts service: %w", err)
+	}
+
+	// without a repository, e.g. when the artifacts are not served, artifact requests fall through to
+	// the Python server.
+	if artifactService.Repository != nil {
+		routes.RegisterArtifactsServiceRoutes(artifactService, parser, app)
+		routes.RegisterArtifactsContentRoutes(artifactService, parser, app)
 	}
 
-	routes.RegisterArtifactsServiceRoutes(artifactService, parser, app)
+	// the reaper is destroyed first, for it not to use the store once it is closed.
+	runReaper := trackingService.StartRunReaper(ctx)
 
-	return app, nil
+	return app, []contract.Destroyer{runReaper, trackingService, modelRegistryService, artifactService}, nil
 }
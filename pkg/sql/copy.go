@@ -0,0 +1,74 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+)
+
+var errNoCopyConnection = errors.New("not a PostgreSQL transaction started by TransactionWithCopy")
+
+type copyConnectionKey struct{}
+
+// TransactionWithCopy runs fn like Transaction, on PostgreSQL on a single connection of database,
+// which lets fn stream rows with CopyFrom within the transaction.
+func TransactionWithCopy(database *gorm.DB, fn func(transaction *gorm.DB) error) error {
+	if database.Dialector.Name() != "postgres" {
+		return Transaction(database, fn)
+	}
+
+	pool, err := database.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database connection pool: %w", err)
+	}
+
+	ctx := database.Statement.Context
+
+	conn, err := pool.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get database connection: %w", err)
+	}
+	defer conn.Close()
+
+	pinned := database.Session(&gorm.Session{Context: context.WithValue(ctx, copyConnectionKey{}, conn)})
+	pinned.Statement.ConnPool = conn
+
+	return Transaction(pinned, fn)
+}
+
+// CanCopy returns whether transaction was started by TransactionWithCopy on PostgreSQL.
+func CanCopy(transaction *gorm.DB) bool {
+	_, ok := transaction.Statement.Context.Value(copyConnectionKey{}).(*sql.Conn)
+
+	return ok
+}
+
+// CopyFrom streams rows into the columns of table with the COPY protocol of PostgreSQL, within a
+// transaction started by TransactionWithCopy.
+func CopyFrom(transaction *gorm.DB, table string, columns []string, rows [][]any) error {
+	ctx := transaction.Statement.Context
+
+	conn, ok := ctx.Value(copyConnectionKey{}).(*sql.Conn)
+	if !ok {
+		return errNoCopyConnection
+	}
+
+	//nolint:wrapcheck
+	return conn.Raw(func(driverConn any) error {
+		pgxConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("%w: %T connection", errNoCopyConnection, driverConn)
+		}
+
+		if _, err := pgxConn.Conn().CopyFrom(ctx, pgx.Identifier{table}, columns, pgx.CopyFromRows(rows)); err != nil {
+			return fmt.Errorf("failed to copy rows into %s: %w", table, err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,23 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestTransactionWithCopyOutsidePostgres(t *testing.T) {
+	t.Parallel()
+
+	database := newSqliteDatabase(t)
+
+	require.NoError(t, TransactionWithCopy(database, func(transaction *gorm.DB) error {
+		assert.False(t, CanCopy(transaction))
+
+		return nil
+	}))
+
+	require.ErrorIs(t, CopyFrom(database, "metrics", []string{"key"}, [][]any{{"key"}}), errNoCopyConnection)
+}
@@ -0,0 +1,90 @@
+package sql
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+)
+
+// explainPrefixes are the statements asking the dialects which support it for the plan of a query.
+var explainPrefixes = map[string]string{
+	"mysql":    "EXPLAIN FORMAT=TREE ",
+	"postgres": "EXPLAIN ",
+	"sqlite":   "EXPLAIN QUERY PLAN ",
+}
+
+// Explain returns the SQL query, and its parameters, which finding dest with query runs. It also
+// returns the plan of the database for it, on the dialects which support it. The query is not run.
+func Explain(query *gorm.DB, dest any) (*entities.SearchExplanation, error) {
+	statement := query.Session(&gorm.Session{DryRun: true}).Find(dest).Statement
+	if statement.Error != nil {
+		return nil, fmt.Errorf("failed to build query: %w", statement.Error)
+	}
+
+	explanation := &entities.SearchExplanation{
+		SQL:    statement.SQL.String(),
+		Params: statement.Vars,
+	}
+
+	prefix, ok := explainPrefixes[query.Dialector.Name()]
+	if !ok {
+		return explanation, nil
+	}
+
+	// the query is already built for the dialect, so it bypasses gorm.
+	rows, err := query.Statement.ConnPool.QueryContext(
+		query.Statement.Context, prefix+explanation.SQL, explanation.Params...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to explain query: %w", err)
+	}
+	defer rows.Close()
+
+	explanation.Plan, err = readPlan(query.Dialector.Name(), rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return explanation, nil
+}
+
+// readPlan reads the plan of the query: lines of text or, on SQLite, nodes which are indented
+// below their parent.
+func readPlan(dialect string, rows *sql.Rows) ([]string, error) {
+	var (
+		plan   []string
+		depths = map[int64]int{}
+	)
+
+	for rows.Next() {
+		var line string
+
+		if dialect == "sqlite" {
+			var (
+				id, parent, notUsed int64
+				detail              string
+			)
+
+			if err := rows.Scan(&id, &parent, &notUsed, &detail); err != nil {
+				return nil, fmt.Errorf("failed to read plan: %w", err)
+			}
+
+			depths[id] = depths[parent] + 1
+			line = strings.Repeat("  ", depths[id]-1) + detail
+		} else if err := rows.Scan(&line); err != nil {
+			return nil, fmt.Errorf("failed to read plan: %w", err)
+		}
+
+		plan = append(plan, strings.Split(line, "\n")...)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read plan: %w", err)
+	}
+
+	return plan, nil
+}
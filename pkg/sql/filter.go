@@ -0,0 +1,192 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// Filter is the SQL translation of a single comparison of a search filter. Comparisons on
+// the columns of the searched table are a Where condition, the others a Subquery selecting
+// the keys of the matching rows, or, for an AntiJoin, of the rows which don't match.
+type Filter struct {
+	Where    string
+	Value    any
+	Subquery *gorm.DB
+	// Columns are the columns of Subquery holding the keys of the searched table,
+	// the names of these keys when nil.
+	Columns  []string
+	AntiJoin bool
+}
+
+// FilterTranslator translates the comparisons of the filter of an entity to SQL.
+type FilterTranslator interface {
+	TranslateFilter(database *gorm.DB, comparison *parser.ValidCompareExpr) (*Filter, *contract.Error)
+}
+
+// FilterEntity is a searchable entity: its table, the schema of its filter and how the
+// comparisons of the filter translate to SQL.
+type FilterEntity struct {
+	Table string
+	// Keys are the columns identifying a row of Table.
+	Keys       []string
+	Schema     *parser.Schema
+	Translator FilterTranslator
+}
+
+// ComparisonCondition returns the condition comparing column to the value of comparison,
+// emulating ILIKE on SQLite.
+func ComparisonCondition(database *gorm.DB, column string, comparison *parser.ValidCompareExpr) (string, any) {
+	value := comparison.Value
+
+	if comparison.Operator == parser.ILike && database.Dialector.Name() == "sqlite" {
+		if str, ok := value.(string); ok {
+			value = strings.ToLower(str)
+		}
+
+		return fmt.Sprintf("LOWER(%s) LIKE ?", column), value
+	}
+
+	return fmt.Sprintf("%s %s ?", column, comparison.Operator), value
+}
+
+// joinCondition matches the rows of the entity with the ones of table, the alias of subquery.
+func (e *FilterEntity) joinCondition(filter *Filter, table string) string {
+	conditions := make([]string, 0, len(e.Keys))
+
+	for index, key := range e.Keys {
+		column := key
+		if filter.Columns != nil {
+			column = filter.Columns[index]
+		}
+
+		conditions = append(conditions, fmt.Sprintf("%s.%s = %s.%s", e.Table, key, table, column))
+	}
+
+	return strings.Join(conditions, " AND ")
+}
+
+// exists is the correlated EXISTS, or NOT EXISTS for an anti-join, of the subquery of filter.
+func (e *FilterEntity) exists(filter *Filter, table string) clause.Expr {
+	exists := "EXISTS"
+	if filter.AntiJoin {
+		exists = "NOT EXISTS"
+	}
+
+	return clause.Expr{
+		SQL:  fmt.Sprintf("%s (SELECT 1 FROM (?) AS %s WHERE %s)", exists, table, e.joinCondition(filter, table)),
+		Vars: []any{filter.Subquery},
+	}
+}
+
+// condition translates the operands of OR and NOT to conditions. Comparisons which are not
+// on the columns of the table become correlated EXISTS subqueries, as joins cannot express
+// a disjunction or negation. table is the alias prefix of these subqueries.
+func (e *FilterEntity) condition(
+	database *gorm.DB, expr parser.Expr, table string,
+) (clause.Expression, *contract.Error) {
+	combine := func(exprs []parser.Expr, operator string) (clause.Expression, *contract.Error) {
+		conditions := make([]any, 0, len(exprs))
+
+		for index, expr := range exprs {
+			condition, err := e.condition(database, expr, fmt.Sprintf("%s_%d", table, index))
+			if err != nil {
+				return nil, err
+			}
+
+			conditions = append(conditions, condition)
+		}
+
+		return clause.Expr{
+			SQL:  "(" + strings.Repeat("? "+operator+" ", len(conditions)-1) + "?)",
+			Vars: conditions,
+		}, nil
+	}
+
+	switch typedExpr := expr.(type) {
+	case *parser.ValidCompareExpr:
+		filter, err := e.Translator.TranslateFilter(database, typedExpr)
+		if err != nil {
+			return nil, err
+		}
+
+		if filter.Subquery == nil {
+			return clause.Expr{SQL: filter.Where, Vars: []any{filter.Value}}, nil
+		}
+
+		return e.exists(filter, table), nil
+	case *parser.AndExpr:
+		return combine(typedExpr.Exprs, "AND")
+	case *parser.OrExpr:
+		return combine(typedExpr.Exprs, "OR")
+	case *parser.NotExpr:
+		condition, err := e.condition(database, typedExpr.Expr, table)
+		if err != nil {
+			return nil, err
+		}
+
+		return clause.Expr{SQL: "NOT (?)", Vars: []any{condition}}, nil
+	default:
+		return nil, contract.NewError(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("error translating search filter: unexpected expression %s", expr),
+		)
+	}
+}
+
+// ApplyFilter restricts transaction to the rows of entity matching filter. The comparisons of
+// the top level conjunction are joined, like MLflow does, apart from the anti-joins.
+func ApplyFilter(
+	ctx context.Context, database, transaction *gorm.DB, entity *FilterEntity, filter string,
+) *contract.Error {
+	filterExpression, err := query.Parse(filter, entity.Schema)
+	if err != nil {
+		return query.NewFilterError(err)
+	}
+
+	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterExpression.Exprs)
+
+	for index, expr := range filterExpression.Exprs {
+		table := fmt.Sprintf("filter_%d", index)
+
+		compareExpr, isComparison := expr.(*parser.ValidCompareExpr)
+		if !isComparison {
+			condition, err := entity.condition(database, expr, table)
+			if err != nil {
+				return err
+			}
+
+			transaction.Where(condition)
+
+			continue
+		}
+
+		filter, contractError := entity.Translator.TranslateFilter(database, compareExpr)
+		if contractError != nil {
+			return contractError
+		}
+
+		switch {
+		case filter.Subquery == nil:
+			transaction.Where(filter.Where, filter.Value)
+		case filter.AntiJoin:
+			transaction.Where(entity.exists(filter, table))
+		default:
+			transaction.Joins(
+				fmt.Sprintf("JOIN (?) AS %s ON %s", table, entity.joinCondition(filter, table)),
+				filter.Subquery,
+			)
+		}
+	}
+
+	return nil
+}
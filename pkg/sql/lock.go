@@ -0,0 +1,49 @@
+package sql
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// TryLock takes the lock of name for transaction without waiting for it, returning whether it got
+// it, for a single server to run a job the servers share a database for. The lock is released at the
+// end of transaction, except on MySQL where it belongs to the connection until Unlock. SQLite needs
+// no lock, its database not being shared by servers.
+func TryLock(transaction *gorm.DB, name string) (bool, error) {
+	var query string
+
+	switch transaction.Dialector.Name() {
+	case "postgres":
+		query = "SELECT pg_try_advisory_xact_lock(hashtext(?))"
+	case "mysql":
+		query = "SELECT GET_LOCK(?, 0) = 1"
+	case "sqlserver":
+		query = "DECLARE @result int; " +
+			"EXEC @result = sp_getapplock @Resource = ?, @LockMode = 'Exclusive', @LockOwner = 'Transaction', " +
+			"@LockTimeout = 0; SELECT CAST(CASE WHEN @result >= 0 THEN 1 ELSE 0 END AS bit)"
+	default:
+		return true, nil
+	}
+
+	var locked bool
+	if err := transaction.Raw(query, name).Scan(&locked).Error; err != nil {
+		return false, fmt.Errorf("failed to take lock %s: %w", name, err)
+	}
+
+	return locked, nil
+}
+
+// Unlock releases the lock of name taken by TryLock on MySQL, the other databases releasing it at
+// the end of the transaction.
+func Unlock(transaction *gorm.DB, name string) error {
+	if transaction.Dialector.Name() != "mysql" {
+		return nil
+	}
+
+	if err := transaction.Exec("DO RELEASE_LOCK(?)", name).Error; err != nil {
+		return fmt.Errorf("failed to release lock %s: %w", name, err)
+	}
+
+	return nil
+}
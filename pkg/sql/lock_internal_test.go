@@ -0,0 +1,22 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestTryLock(t *testing.T) {
+	t.Parallel()
+
+	// the database of SQLite is not shared by servers, so the lock is always taken.
+	require.NoError(t, Transaction(newSqliteDatabase(t), func(transaction *gorm.DB) error {
+		locked, err := TryLock(transaction, "lock")
+		require.NoError(t, err)
+		assert.True(t, locked)
+
+		return Unlock(transaction, "lock")
+	}))
+}
@@ -0,0 +1,181 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+var (
+	errUnknownColumn   = errors.New("unknown column")
+	errUnknownRevision = errors.New("unknown database schema revision")
+	errOutdatedSchema  = errors.New("detected out-of-date database schema")
+	errUnmanagedSchema = errors.New("database has MLflow tables but no alembic_version table")
+)
+
+var alembicVersionTable = &table{
+	name:        "alembic_version",
+	columns:     []column{str("version_num", 32).required()},
+	constraints: []constraint{primaryKey("alembic_version_pkc", "version_num")},
+}
+
+// currentRevision returns the revision of alembic_version, empty for a database without MLflow tables.
+func currentRevision(database *gorm.DB) (string, error) {
+	if !database.Migrator().HasTable(alembicVersionTable.name) {
+		if database.Migrator().HasTable(experimentsTable.name) {
+			return "", errUnmanagedSchema
+		}
+
+		return "", nil
+	}
+
+	var revisions []string
+	if err := database.Table(alembicVersionTable.name).Pluck("version_num", &revisions).Error; err != nil {
+		return "", fmt.Errorf("failed to read alembic_version: %w", err)
+	}
+
+	if len(revisions) == 0 {
+		return "", nil
+	}
+
+	return revisions[0], nil
+}
+
+func setRevision(database *gorm.DB, revision string) error {
+	if err := database.Table(alembicVersionTable.name).
+		Where("1 = 1").
+		Update("version_num", revision).Error; err != nil {
+		return fmt.Errorf("failed to update alembic_version to %s: %w", revision, err)
+	}
+
+	return nil
+}
+
+// initialize creates the schema of baselineRevision in an empty database.
+func initialize(database *gorm.DB) error {
+	if err := Transaction(database, func(transaction *gorm.DB) error {
+		if !transaction.Migrator().HasTable(alembicVersionTable.name) {
+			if err := alembicVersionTable.create(transaction); err != nil {
+				return err
+			}
+		}
+
+		if err := createBaseline(transaction); err != nil {
+			return err
+		}
+
+		if err := transaction.Table(alembicVersionTable.name).
+			Create(map[string]any{"version_num": baselineRevision}).Error; err != nil {
+			return fmt.Errorf("failed to write alembic_version: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to create database schema: %w", err)
+	}
+
+	return nil
+}
+
+// Migrate checks that the schema of database is at the head of the MLflow Alembic revisions, which
+// `mlflow db upgrade` and MLflow itself also record in alembic_version.
+//
+// When upgrade is true, an empty database is initialized and a schema at a revision from the
+// baseline on is upgraded to the head, each revision in its own transaction, an older revision then
+// being an error. Otherwise an out-of-date schema is only reported, as the MLflow version the server
+// runs with may still support it. A revision unknown to this server, e.g. written by a newer MLflow,
+// is always an error.
+func Migrate(ctx context.Context, database *gorm.DB, upgrade bool) error {
+	logger := utils.GetLoggerFromContext(ctx)
+	database = database.WithContext(ctx)
+
+	revision, err := currentRevision(database)
+	if err != nil {
+		return err
+	}
+
+	if revision == "" {
+		if !upgrade {
+			logger.Warn("Database has no MLflow schema, set database_upgrade to create it")
+
+			return nil
+		}
+
+		logger.Infof("Creating database schema at revision %s", baselineRevision)
+
+		if err := initialize(database); err != nil {
+			return err
+		}
+
+		revision = baselineRevision
+	}
+
+	if revision == headRevision() {
+		return nil
+	}
+
+	if err := checkUpgradable(revision); err != nil {
+		if !upgrade && !errors.Is(err, errUnknownRevision) {
+			logger.Warnf("The Go server may fail to use the database: %v", err)
+
+			return nil
+		}
+
+		return err
+	}
+
+	if !upgrade {
+		logger.Warnf(
+			"%v (found version %s, but expected %s), the Go server may fail to use the database. "+
+				"Take a backup of your database, then restart with database_upgrade set",
+			errOutdatedSchema, revision, headRevision(),
+		)
+
+		return nil
+	}
+
+	position := slices.IndexFunc(migrations, func(m migration) bool { return m.revision == revision })
+	for _, migration := range migrations[position+1:] {
+		logger.Infof("Upgrading database schema to %s, %s", migration.revision, migration.description)
+
+		if err := Transaction(database, func(transaction *gorm.DB) error {
+			if err := migration.upgrade(transaction); err != nil {
+				return err
+			}
+
+			return setRevision(transaction, migration.revision)
+		}); err != nil {
+			return fmt.Errorf("failed to upgrade database schema to %s: %w", migration.revision, err)
+		}
+	}
+
+	return nil
+}
+
+// checkUpgradable returns an error unless the schema at revision can be upgraded to the head by
+// Migrate, which only knows the upgrades from baselineRevision on.
+func checkUpgradable(revision string) error {
+	position := slices.IndexFunc(migrations, func(m migration) bool { return m.revision == revision })
+	if position == -1 {
+		return fmt.Errorf(
+			"%w %s, the database was probably migrated by a newer version of MLflow (expected %s)",
+			errUnknownRevision, revision, headRevision(),
+		)
+	}
+
+	baseline := slices.IndexFunc(migrations, func(m migration) bool { return m.revision == baselineRevision })
+	if position < baseline {
+		return fmt.Errorf(
+			"%w (found version %s, but expected %s). "+
+				"Take a backup of your database, then run 'mlflow db upgrade <database_uri>'",
+			errOutdatedSchema, revision, headRevision(),
+		)
+	}
+
+	return nil
+}
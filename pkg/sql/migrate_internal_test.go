@@ -0,0 +1,245 @@
+package sql
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+)
+
+func newSqliteDatabase(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	database, err := NewDatabase(
+		context.Background(), "sqlite:///"+filepath.Join(t.TempDir(), "mlflow.db"), config.DatabaseConfig{},
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, CloseDatabase(database))
+	})
+
+	return database
+}
+
+func requireRevision(t *testing.T, database *gorm.DB, expected string) {
+	t.Helper()
+
+	revision, err := currentRevision(database)
+	require.NoError(t, err)
+	assert.Equal(t, expected, revision)
+}
+
+func TestMigrateEmptyDatabase(t *testing.T) {
+	t.Parallel()
+
+	database := newSqliteDatabase(t)
+
+	require.NoError(t, Migrate(context.Background(), database, false))
+	assert.False(t, database.Migrator().HasTable("experiments"))
+
+	require.NoError(t, Migrate(context.Background(), database, true))
+	requireRevision(t, database, headRevision())
+
+	for _, table := range []string{"experiments", "runs", "metrics", "logged_models", "assessments"} {
+		assert.True(t, database.Migrator().HasTable(table), table)
+	}
+
+	require.NoError(t, Migrate(context.Background(), database, false))
+}
+
+func TestMigrateUpgradesFromBaseline(t *testing.T) {
+	t.Parallel()
+
+	database := newSqliteDatabase(t)
+	require.NoError(t, initialize(database))
+	require.NoError(t, database.Exec(
+		"INSERT INTO model_versions (name, version) VALUES ('model', 1)",
+	).Error)
+	require.NoError(t, database.Exec(
+		"INSERT INTO model_version_tags (key, value, name, version) VALUES ('key', 'value', 'model', 1)",
+	).Error)
+
+	// without database_upgrade, an out-of-date schema is only reported.
+	require.NoError(t, Migrate(context.Background(), database, false))
+	requireRevision(t, database, baselineRevision)
+
+	require.NoError(t, Migrate(context.Background(), database, true))
+	requireRevision(t, database, headRevision())
+
+	assert.True(t, database.Migrator().HasColumn("inputs", "step"))
+	assert.True(t, database.Migrator().HasColumn("trace_info", "client_request_id"))
+
+	var value string
+	require.NoError(t, database.Table("model_version_tags").Pluck("value", &value).Error)
+	assert.Equal(t, "value", value)
+}
+
+func TestMigrateRefusesUnsupportedRevisions(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		revision string
+		err      error
+		// withoutUpgrade is the error of Migrate without upgrade.
+		withoutUpgrade error
+	}{
+		{revision: "451aebb31d03", err: errOutdatedSchema},
+		{revision: "ffffffffffff", err: errUnknownRevision, withoutUpgrade: errUnknownRevision},
+	} {
+		t.Run(test.revision, func(t *testing.T) {
+			t.Parallel()
+
+			database := newSqliteDatabase(t)
+			require.NoError(t, initialize(database))
+			require.NoError(t, setRevision(database, test.revision))
+
+			require.ErrorIs(t, Migrate(context.Background(), database, true), test.err)
+			requireRevision(t, database, test.revision)
+
+			err := Migrate(context.Background(), database, false)
+			if test.withoutUpgrade == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, test.withoutUpgrade)
+			}
+
+			requireRevision(t, database, test.revision)
+		})
+	}
+}
+
+// dryRunStatements returns the statements upgrade runs on a database of dialector, without a server.
+func dryRunStatements(t *testing.T, dialector gorm.Dialector, upgrade func(database *gorm.DB) error) []string {
+	t.Helper()
+
+	database, err := gorm.Open(dialector, &gorm.Config{DryRun: true})
+	require.NoError(t, err)
+
+	var statements []string
+
+	require.NoError(t, database.Callback().Raw().After("gorm:raw").Register("test:statements", func(db *gorm.DB) {
+		statements = append(statements, db.Statement.SQL.String())
+	}))
+	require.NoError(t, upgrade(database))
+
+	return statements
+}
+
+// TestMigrationStatements checks the DDL of the migrations changing constraints on the dialects
+// which, unlike SQLite, alter tables in place.
+func TestMigrationStatements(t *testing.T) {
+	t.Parallel()
+
+	newPostgresDialector := func() gorm.Dialector {
+		mockedDB, _, _ := sqlmock.New()
+
+		return postgres.New(postgres.Config{Conn: mockedDB, DriverName: "postgres"})
+	}
+	newMySQLDialector := func() gorm.Dialector {
+		mockedDB, _, _ := sqlmock.New()
+
+		return mysql.New(mysql.Config{Conn: mockedDB, DriverName: "mysql", SkipInitializeWithVersion: true})
+	}
+
+	for _, test := range []struct {
+		name         string
+		newDialector func() gorm.Dialector
+		upgrade      func(database *gorm.DB) error
+		expected     []string
+	}{
+		{
+			name:         "PostgresModelVersionTags",
+			newDialector: newPostgresDialector,
+			upgrade:      alterModelVersionTagsValue,
+			expected:     []string{`ALTER TABLE "model_version_tags" ALTER COLUMN "value" TYPE TEXT`},
+		},
+		{
+			name:         "MySQLModelVersionTags",
+			newDialector: newMySQLDialector,
+			upgrade:      alterModelVersionTagsValue,
+			expected:     []string{"ALTER TABLE `model_version_tags` MODIFY `value` TEXT"},
+		},
+		{
+			name:         "PostgresLoggedModels",
+			newDialector: newPostgresDialector,
+			upgrade:      createLoggedModelTables,
+			expected: []string{
+				`CONSTRAINT "logged_models_lifecycle_stage_check" CHECK (lifecycle_stage IN ('active', 'deleted'))`,
+				`CONSTRAINT "fk_logged_models_experiment_id" FOREIGN KEY("experiment_id") ` +
+					`REFERENCES "experiments" ("experiment_id") ON DELETE CASCADE`,
+				`CONSTRAINT "fk_logged_model_metrics_run_id" FOREIGN KEY("run_id") ` +
+					`REFERENCES "runs" ("run_uuid") ON DELETE CASCADE`,
+				`CREATE INDEX "index_logged_model_metrics_model_id" ON "logged_model_metrics" ("model_id")`,
+			},
+		},
+		{
+			name:         "MySQLLoggedModels",
+			newDialector: newMySQLDialector,
+			upgrade:      createLoggedModelTables,
+			expected: []string{
+				"CONSTRAINT `logged_models_lifecycle_stage_check` CHECK (lifecycle_stage IN ('active', 'deleted'))",
+				"CONSTRAINT `fk_logged_models_experiment_id` FOREIGN KEY(`experiment_id`) " +
+					"REFERENCES `experiments` (`experiment_id`) ON DELETE CASCADE",
+				"CONSTRAINT `fk_logged_model_metrics_run_id` FOREIGN KEY(`run_id`) " +
+					"REFERENCES `runs` (`run_uuid`) ON DELETE CASCADE",
+				"CREATE INDEX `index_logged_model_metrics_model_id` ON `logged_model_metrics` (`model_id`)",
+			},
+		},
+		{
+			name:         "PostgresAssessments",
+			newDialector: newPostgresDialector,
+			upgrade:      createAssessmentsTable,
+			expected: []string{
+				`CONSTRAINT "fk_assessments_trace_id" FOREIGN KEY("trace_id") ` +
+					`REFERENCES "trace_info" ("request_id") ON DELETE CASCADE`,
+				`"valid" BOOLEAN DEFAULT true NOT NULL`,
+			},
+		},
+		{
+			name:         "MySQLAssessments",
+			newDialector: newMySQLDialector,
+			upgrade:      createAssessmentsTable,
+			expected: []string{
+				"CONSTRAINT `fk_assessments_trace_id` FOREIGN KEY(`trace_id`) " +
+					"REFERENCES `trace_info` (`request_id`) ON DELETE CASCADE",
+				"`valid` BOOL DEFAULT '1' NOT NULL",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			statements := strings.Join(dryRunStatements(t, test.newDialector(), test.upgrade), "\n")
+			for _, expected := range test.expected {
+				assert.Contains(t, statements, expected)
+			}
+		})
+	}
+}
+
+func TestCreateServerTables(t *testing.T) {
+	t.Parallel()
+
+	database := newSqliteDatabase(t)
+
+	for range 2 {
+		require.NoError(t, CreateServerTables(context.Background(), database))
+		assert.True(t, database.Migrator().HasTable("idempotency_keys"))
+		assert.True(t, database.Migrator().HasTable("run_heartbeats"))
+	}
+
+	// the indexes added to existing tables are created too.
+	require.NoError(t, database.Migrator().DropIndex("run_heartbeats", "index_run_heartbeats_last_heartbeat"))
+	require.NoError(t, CreateServerTables(context.Background(), database))
+	assert.True(t, database.Migrator().HasIndex("run_heartbeats", "index_run_heartbeats_last_heartbeat"))
+}
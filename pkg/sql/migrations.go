@@ -0,0 +1,562 @@
+package sql
+
+import (
+	"gorm.io/gorm"
+)
+
+// migration is an Alembic revision of the MLflow schema.
+type migration struct {
+	revision    string
+	description string
+	// upgrade migrates the schema from the previous revision, nil for the revisions older than
+	// baselineRevision, which only `mlflow db upgrade` applies.
+	upgrade func(database *gorm.DB) error
+}
+
+// baselineRevision is the revision of the schema created by createBaseline in an empty database.
+const baselineRevision = "0584bdc529eb"
+
+// migrations is the Alembic revision chain of MLflow, oldest first, its last element being the head.
+var migrations = []migration{
+	{revision: "451aebb31d03", description: "add metric step"},
+	{revision: "90e64c465722", description: "migrate user column to tags"},
+	{revision: "181f10493468", description: "allow nulls for metric values"},
+	{revision: "df50e92ffc5e", description: "Add Experiment Tags Table"},
+	{revision: "7ac759974ad8", description: "Update run tags with larger limit"},
+	{revision: "89d4b8295536", description: "create latest metrics table"},
+	{revision: "2b4d017a5e9b", description: "add model registry tables to db"},
+	{revision: "cfd24bdc0731", description: "Update run status constraint with killed"},
+	{revision: "0a8213491aaa", description: "drop_duplicate_killed_constraint"},
+	{revision: "728d730b5ebd", description: "add registered model tags table"},
+	{revision: "27a6a02d2cf1", description: "add model version tags table"},
+	{revision: "84291f40a231", description: "add run_link to model_version"},
+	{revision: "a8c4a736bde6", description: "allow nulls for run_id"},
+	{revision: "39d1c3be5f05", description: "add_is_nan_constraint_for_metrics_tables_if_necessary"},
+	{revision: "c48cb773bb87", description: "reset_default_value_for_is_nan_in_metrics_table_for_mysql"},
+	{revision: "bd07f7e963c5", description: "create index on run_uuid"},
+	{revision: "0c779009ac13", description: "add deleted_time field to runs table"},
+	{revision: "cc1f77228345", description: "change param value length to 500"},
+	{revision: "97727af70f4d", description: "Add creation_time and last_update_time to experiments table"},
+	{revision: "3500859a5d39", description: "Add Model Aliases table"},
+	{revision: "7f2a7d5fae7d", description: "add datasets inputs input_tags tables"},
+	{revision: "2d6e25af4d3e", description: "increase max param val length from 500 to 8000"},
+	{revision: "acf3f17fdcc7", description: "add storage location field to model versions"},
+	{revision: "867495a8f9d4", description: "add trace tables"},
+	{revision: "5b0e9adcef9c", description: "add cascade deletion to trace tables foreign keys"},
+	{revision: "4465047574b1", description: "increase max dataset schema size"},
+	{revision: "f5a4f2784254", description: "increase run tag value limit to 8000"},
+	{revision: "0584bdc529eb", description: "add cascading deletion to datasets from experiments"},
+	{revision: "400f98739977", description: "add logged model tables", upgrade: createLoggedModelTables},
+	{revision: "6953534de441", description: "add step to inputs table", upgrade: addInputsStep},
+	{revision: "bda7b8c39065", description: "increase model version tag value limit", upgrade: alterModelVersionTagsValue},
+	{revision: "cbc13b556ace", description: "add V3 trace schema columns", upgrade: addTraceInfoV3Columns},
+	{revision: "770bee3ae1dd", description: "add assessments table", upgrade: createAssessmentsTable},
+}
+
+func headRevision() string {
+	return migrations[len(migrations)-1].revision
+}
+
+var lifecycleStageCondition = "lifecycle_stage IN ('active', 'deleted')"
+
+var experimentsTable = &table{
+	name: "experiments",
+	columns: []column{
+		{name: "experiment_id", kind: integerColumn, notNull: true, autoIncrement: true},
+		str("name", 256).required(),
+		str("artifact_location", 256),
+		str("lifecycle_stage", 32),
+		bigInteger("creation_time"),
+		bigInteger("last_update_time"),
+	},
+	constraints: []constraint{
+		primaryKey("experiment_pk", "experiment_id"),
+		unique("name"),
+		check("experiments_lifecycle_stage", lifecycleStageCondition),
+	},
+}
+
+var experimentTagsTable = &table{
+	name: "experiment_tags",
+	columns: []column{
+		str("key", 250).required(),
+		str("value", 5000),
+		integer("experiment_id").required(),
+	},
+	constraints: []constraint{
+		primaryKey("experiment_tag_pk", "key", "experiment_id"),
+		foreignKey("", []string{"experiment_id"}, "experiments"),
+	},
+}
+
+var runsTable = &table{
+	name: "runs",
+	columns: []column{
+		str("run_uuid", 32).required(),
+		str("name", 250),
+		str("source_type", 20),
+		str("source_name", 500),
+		str("entry_point_name", 50),
+		str("user_id", 256),
+		str("status", 9),
+		bigInteger("start_time"),
+		bigInteger("end_time"),
+		str("source_version", 50),
+		str("lifecycle_stage", 20),
+		str("artifact_uri", 200),
+		integer("experiment_id"),
+		bigInteger("deleted_time"),
+	},
+	constraints: []constraint{
+		primaryKey("run_pk", "run_uuid"),
+		check("source_type", "source_type IN ('NOTEBOOK', 'JOB', 'LOCAL', 'UNKNOWN', 'PROJECT')"),
+		check("runs_lifecycle_stage", lifecycleStageCondition),
+		check("runs_status_check", "status IN ('SCHEDULED', 'FAILED', 'FINISHED', 'RUNNING', 'KILLED')"),
+		foreignKey("", []string{"experiment_id"}, "experiments"),
+	},
+}
+
+var metricsTable = &table{
+	name: "metrics",
+	columns: []column{
+		str("key", 250).required(),
+		float("value").required(),
+		bigInteger("timestamp").required(),
+		str("run_uuid", 32).required(),
+		bigInteger("step").withDefault("0").required(),
+		boolean("is_nan").withDefault("0").required(),
+	},
+	constraints: []constraint{
+		primaryKey("metric_pk", "key", "timestamp", "step", "run_uuid", "value", "is_nan"),
+		foreignKey("", []string{"run_uuid"}, "runs"),
+	},
+	indexes: []index{{name: "index_metrics_run_uuid", columns: []string{"run_uuid"}}},
+}
+
+var latestMetricsTable = &table{
+	name: "latest_metrics",
+	columns: []column{
+		str("key", 250).required(),
+		float("value").required(),
+		bigInteger("timestamp"),
+		bigInteger("step").required(),
+		boolean("is_nan").required(),
+		str("run_uuid", 32).required(),
+	},
+	constraints: []constraint{
+		primaryKey("latest_metric_pk", "key", "run_uuid"),
+		foreignKey("", []string{"run_uuid"}, "runs"),
+	},
+	indexes: []index{{name: "index_latest_metrics_run_uuid", columns: []string{"run_uuid"}}},
+}
+
+var paramsTable = &table{
+	name: "params",
+	columns: []column{
+		str("key", 250).required(),
+		str("value", 8000).required(),
+		str("run_uuid", 32).required(),
+	},
+	constraints: []constraint{
+		primaryKey("param_pk", "key", "run_uuid"),
+		foreignKey("", []string{"run_uuid"}, "runs"),
+	},
+	indexes: []index{{name: "index_params_run_uuid", columns: []string{"run_uuid"}}},
+}
+
+var tagsTable = &table{
+	name: "tags",
+	columns: []column{
+		str("key", 250).required(),
+		str("value", 8000),
+		str("run_uuid", 32).required(),
+	},
+	constraints: []constraint{
+		primaryKey("tag_pk", "key", "run_uuid"),
+		foreignKey("", []string{"run_uuid"}, "runs"),
+	},
+	indexes: []index{{name: "index_tags_run_uuid", columns: []string{"run_uuid"}}},
+}
+
+var registeredModelsTable = &table{
+	name: "registered_models",
+	columns: []column{
+		str("name", 256).required(),
+		bigInteger("creation_time"),
+		bigInteger("last_updated_time"),
+		str("description", 5000),
+	},
+	constraints: []constraint{
+		primaryKey("registered_model_pk", "name"),
+		unique("name"),
+	},
+}
+
+var registeredModelTagsTable = &table{
+	name: "registered_model_tags",
+	columns: []column{
+		str("key", 250).required(),
+		str("value", 5000),
+		str("name", 256).required(),
+	},
+	constraints: []constraint{
+		primaryKey("registered_model_tag_pk", "key", "name"),
+		foreignKey("", []string{"name"}, "registered_models", "ON UPDATE CASCADE"),
+	},
+}
+
+var modelVersionsTable = &table{
+	name: "model_versions",
+	columns: []column{
+		str("name", 256).required(),
+		integer("version").required(),
+		bigInteger("creation_time"),
+		bigInteger("last_updated_time"),
+		str("description", 5000),
+		str("user_id", 256),
+		str("current_stage", 20),
+		str("source", 500),
+		str("run_id", 32),
+		str("status", 20),
+		str("status_message", 500),
+		str("run_link", 500),
+		str("storage_location", 500),
+	},
+	constraints: []constraint{
+		primaryKey("model_version_pk", "name", "version"),
+		foreignKey("", []string{"name"}, "registered_models", "ON UPDATE CASCADE"),
+	},
+}
+
+// modelVersionTagsTable is the table before bda7b8c39065, see modelVersionTagsTableWithTextValue.
+var modelVersionTagsTable = &table{
+	name: "model_version_tags",
+	columns: []column{
+		str("key", 250).required(),
+		str("value", 5000),
+		str("name", 256).required(),
+		integer("version").required(),
+	},
+	constraints: []constraint{
+		primaryKey("model_version_tag_pk", "key", "name", "version"),
+		foreignKey("", []string{"name", "version"}, "model_versions", "ON UPDATE CASCADE"),
+	},
+}
+
+var registeredModelAliasesTable = &table{
+	name: "registered_model_aliases",
+	columns: []column{
+		str("alias", 256).required(),
+		integer("version").required(),
+		str("name", 256).required(),
+	},
+	constraints: []constraint{
+		primaryKey("registered_model_alias_pk", "name", "alias"),
+		foreignKey(
+			"registered_model_alias_name_fkey", []string{"name"}, "registered_models",
+			"ON DELETE CASCADE", "ON UPDATE CASCADE",
+		),
+	},
+}
+
+var datasetsTable = &table{
+	name: "datasets",
+	columns: []column{
+		str("dataset_uuid", 36).required(),
+		integer("experiment_id").required(),
+		str("name", 500).required(),
+		str("digest", 36).required(),
+		str("dataset_source_type", 36).required(),
+		text("dataset_source").required(),
+		mediumText("dataset_schema"),
+		mediumText("dataset_profile"),
+	},
+	constraints: []constraint{
+		primaryKey("dataset_pk", "experiment_id", "name", "digest"),
+		foreignKey("fk_datasets_experiment_id_experiments", []string{"experiment_id"}, "experiments", "ON DELETE CASCADE"),
+	},
+	indexes: []index{
+		{name: "index_datasets_dataset_uuid", columns: []string{"dataset_uuid"}},
+		{
+			name:    "index_datasets_experiment_id_dataset_source_type",
+			columns: []string{"experiment_id", "dataset_source_type"},
+		},
+	},
+}
+
+// inputsTable is the table before 6953534de441, see inputsTableWithStep.
+var inputsTable = &table{
+	name: "inputs",
+	columns: []column{
+		str("input_uuid", 36).required(),
+		str("source_type", 36).required(),
+		str("source_id", 36).required(),
+		str("destination_type", 36).required(),
+		str("destination_id", 36).required(),
+	},
+	constraints: []constraint{
+		primaryKey("inputs_pk", "source_type", "source_id", "destination_type", "destination_id"),
+	},
+	indexes: []index{
+		{name: "index_inputs_input_uuid", columns: []string{"input_uuid"}},
+		{
+			name:    "index_inputs_destination_type_destination_id_source_type",
+			columns: []string{"destination_type", "destination_id", "source_type"},
+		},
+	},
+}
+
+var inputTagsTable = &table{
+	name: "input_tags",
+	columns: []column{
+		str("input_uuid", 36).required(),
+		str("name", 255).required(),
+		str("value", 500).required(),
+	},
+	constraints: []constraint{
+		primaryKey("input_tags_pk", "input_uuid", "name"),
+	},
+}
+
+// traceInfoTable is the table before cbc13b556ace, see traceInfoTableV3.
+var traceInfoTable = &table{
+	name: "trace_info",
+	columns: []column{
+		str("request_id", 50).required(),
+		integer("experiment_id").required(),
+		bigInteger("timestamp_ms").required(),
+		bigInteger("execution_time_ms"),
+		str("status", 50).required(),
+	},
+	constraints: []constraint{
+		primaryKey("trace_info_pk", "request_id"),
+		foreignKey("fk_trace_info_experiment_id", []string{"experiment_id"}, "experiments"),
+	},
+	indexes: []index{
+		{name: "index_trace_info_experiment_id_timestamp_ms", columns: []string{"experiment_id", "timestamp_ms"}},
+	},
+}
+
+var traceTagsTable = &table{
+	name: "trace_tags",
+	columns: []column{
+		str("key", 250).required(),
+		str("value", 8000),
+		str("request_id", 50).required(),
+	},
+	constraints: []constraint{
+		primaryKey("trace_tag_pk", "key", "request_id"),
+		foreignKey("fk_trace_tags_request_id", []string{"request_id"}, "trace_info", "ON DELETE CASCADE"),
+	},
+	indexes: []index{{name: "index_trace_tags_request_id", columns: []string{"request_id"}}},
+}
+
+var traceRequestMetadataTable = &table{
+	name: "trace_request_metadata",
+	columns: []column{
+		str("key", 250).required(),
+		str("value", 8000),
+		str("request_id", 50).required(),
+	},
+	constraints: []constraint{
+		primaryKey("trace_request_metadata_pk", "key", "request_id"),
+		foreignKey("fk_trace_request_metadata_request_id", []string{"request_id"}, "trace_info", "ON DELETE CASCADE"),
+	},
+	indexes: []index{{name: "index_trace_request_metadata_request_id", columns: []string{"request_id"}}},
+}
+
+// createBaseline creates the schema of baselineRevision.
+func createBaseline(database *gorm.DB) error {
+	return createTables(
+		database,
+		experimentsTable,
+		experimentTagsTable,
+		runsTable,
+		metricsTable,
+		latestMetricsTable,
+		paramsTable,
+		tagsTable,
+		registeredModelsTable,
+		registeredModelTagsTable,
+		modelVersionsTable,
+		modelVersionTagsTable,
+		registeredModelAliasesTable,
+		datasetsTable,
+		inputsTable,
+		inputTagsTable,
+		traceInfoTable,
+		traceTagsTable,
+		traceRequestMetadataTable,
+	)
+}
+
+var loggedModelsTable = &table{
+	name: "logged_models",
+	columns: []column{
+		str("model_id", 36).required(),
+		integer("experiment_id").required(),
+		str("name", 500).required(),
+		str("artifact_location", 1000).required(),
+		bigInteger("creation_timestamp_ms").required(),
+		bigInteger("last_updated_timestamp_ms").required(),
+		integer("status").required(),
+		str("lifecycle_stage", 32),
+		str("model_type", 500),
+		str("source_run_id", 32),
+		str("status_message", 1000),
+	},
+	constraints: []constraint{
+		primaryKey("logged_models_pk", "model_id"),
+		check("logged_models_lifecycle_stage_check", lifecycleStageCondition),
+		foreignKey("fk_logged_models_experiment_id", []string{"experiment_id"}, "experiments", "ON DELETE CASCADE"),
+	},
+}
+
+var loggedModelMetricsTable = &table{
+	name: "logged_model_metrics",
+	columns: []column{
+		str("model_id", 36).required(),
+		str("metric_name", 500).required(),
+		bigInteger("metric_timestamp_ms").required(),
+		bigInteger("metric_step").required(),
+		float("metric_value"),
+		integer("experiment_id").required(),
+		str("run_id", 32).required(),
+		str("dataset_uuid", 36),
+		str("dataset_name", 500),
+		str("dataset_digest", 36),
+	},
+	constraints: []constraint{
+		primaryKey(
+			"logged_model_metrics_pk", "model_id", "metric_name", "metric_timestamp_ms", "metric_step", "run_id",
+		),
+		foreignKey("fk_logged_model_metrics_model_id", []string{"model_id"}, "logged_models", "ON DELETE CASCADE"),
+		foreignKey("fk_logged_model_metrics_experiment_id", []string{"experiment_id"}, "experiments"),
+		foreignKeyTo(
+			"fk_logged_model_metrics_run_id", []string{"run_id"}, "runs", []string{"run_uuid"}, "ON DELETE CASCADE",
+		),
+	},
+	indexes: []index{{name: "index_logged_model_metrics_model_id", columns: []string{"model_id"}}},
+}
+
+var loggedModelParamsTable = &table{
+	name: "logged_model_params",
+	columns: []column{
+		str("model_id", 36).required(),
+		integer("experiment_id").required(),
+		str("param_key", 255).required(),
+		text("param_value").required(),
+	},
+	constraints: []constraint{
+		primaryKey("logged_model_params_pk", "model_id", "param_key"),
+		foreignKey("fk_logged_model_params_model_id", []string{"model_id"}, "logged_models", "ON DELETE CASCADE"),
+		foreignKey("fk_logged_model_params_experiment_id", []string{"experiment_id"}, "experiments"),
+	},
+}
+
+var loggedModelTagsTable = &table{
+	name: "logged_model_tags",
+	columns: []column{
+		str("model_id", 36).required(),
+		integer("experiment_id").required(),
+		str("tag_key", 255).required(),
+		text("tag_value").required(),
+	},
+	constraints: []constraint{
+		primaryKey("logged_model_tags_pk", "model_id", "tag_key"),
+		foreignKey("fk_logged_model_tags_model_id", []string{"model_id"}, "logged_models", "ON DELETE CASCADE"),
+		foreignKey("fk_logged_model_tags_experiment_id", []string{"experiment_id"}, "experiments"),
+	},
+}
+
+func createLoggedModelTables(database *gorm.DB) error {
+	return createTables(
+		database, loggedModelsTable, loggedModelMetricsTable, loggedModelParamsTable, loggedModelTagsTable,
+	)
+}
+
+var inputsTableWithStep = &table{
+	name:        inputsTable.name,
+	columns:     append(append([]column{}, inputsTable.columns...), bigInteger("step").withDefault("0").required()),
+	constraints: inputsTable.constraints,
+	indexes:     inputsTable.indexes,
+}
+
+func addInputsStep(database *gorm.DB) error {
+	return addColumn(database, inputsTableWithStep, "step")
+}
+
+var modelVersionTagsTableWithTextValue = &table{
+	name: modelVersionTagsTable.name,
+	columns: []column{
+		str("key", 250).required(),
+		text("value"),
+		str("name", 256).required(),
+		integer("version").required(),
+	},
+	constraints: modelVersionTagsTable.constraints,
+}
+
+func alterModelVersionTagsValue(database *gorm.DB) error {
+	return alterColumnType(database, modelVersionTagsTableWithTextValue, "value")
+}
+
+var traceInfoTableV3 = &table{
+	name: traceInfoTable.name,
+	columns: append(
+		append([]column{}, traceInfoTable.columns...),
+		str("client_request_id", 50),
+		str("request_preview", 1000),
+		str("response_preview", 1000),
+	),
+	constraints: traceInfoTable.constraints,
+	indexes:     traceInfoTable.indexes,
+}
+
+func addTraceInfoV3Columns(database *gorm.DB) error {
+	for _, name := range []string{"client_request_id", "request_preview", "response_preview"} {
+		if err := addColumn(database, traceInfoTableV3, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var assessmentsTable = &table{
+	name: "assessments",
+	columns: []column{
+		str("assessment_id", 50).required(),
+		str("trace_id", 50).required(),
+		str("name", 250).required(),
+		str("assessment_type", 20).required(),
+		text("value").required(),
+		text("error"),
+		bigInteger("created_timestamp").required(),
+		bigInteger("last_updated_timestamp").required(),
+		str("source_type", 50).required(),
+		str("source_id", 250),
+		str("run_id", 32),
+		str("span_id", 50),
+		text("rationale"),
+		str("overrides", 50),
+		boolean("valid").withDefault("1").required(),
+		text("assessment_metadata"),
+	},
+	constraints: []constraint{
+		primaryKey("assessments_pk", "assessment_id"),
+		foreignKeyTo(
+			"fk_assessments_trace_id", []string{"trace_id"}, "trace_info", []string{"request_id"}, "ON DELETE CASCADE",
+		),
+	},
+	indexes: []index{
+		{name: "index_assessments_trace_id_created_timestamp", columns: []string{"trace_id", "created_timestamp"}},
+		{name: "index_assessments_run_id_created_timestamp", columns: []string{"run_id", "created_timestamp"}},
+		{name: "index_assessments_last_updated_timestamp", columns: []string{"last_updated_timestamp"}},
+		{name: "index_assessments_assessment_type", columns: []string{"assessment_type"}},
+	},
+}
+
+func createAssessmentsTable(database *gorm.DB) error {
+	return createTables(database, assessmentsTable)
+}
@@ -0,0 +1,151 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+type readReplica struct {
+	// name is the redacted URI of the replica.
+	name     string
+	database *gorm.DB
+	healthy  atomic.Bool
+}
+
+// ReadReplicas routes the reads of the contexts made with utils.NewContextWithReplicaReads to the
+// healthy read replicas of a primary database in turn, and all other reads to the primary.
+type ReadReplicas struct {
+	primary  *gorm.DB
+	replicas []*readReplica
+	next     atomic.Uint64
+	stop     context.CancelFunc
+	stopped  sync.WaitGroup
+}
+
+// NewReadReplicas connects to the replicas of primary at storeURIs, which are pinged every
+// ReplicaHealthCheckInterval of options until Close.
+func NewReadReplicas(
+	ctx context.Context, primary *gorm.DB, storeURIs []string, options config.DatabaseConfig,
+) (*ReadReplicas, error) {
+	replicas := &ReadReplicas{
+		primary:  primary,
+		replicas: make([]*readReplica, 0, len(storeURIs)),
+	}
+
+	for _, storeURI := range storeURIs {
+		database, err := openDatabase(ctx, storeURI, options, true)
+		if err != nil {
+			return nil, errors.Join(
+				fmt.Errorf("failed to connect to read replica: %w", err), replicas.closeReplicas(),
+			)
+		}
+
+		replica := &readReplica{name: storeURI, database: database}
+		if uri, err := url.Parse(storeURI); err == nil {
+			replica.name = uri.Redacted()
+		}
+
+		// the first health check below only logs the replicas that are down.
+		replica.healthy.Store(true)
+
+		replicas.replicas = append(replicas.replicas, replica)
+	}
+
+	for _, replica := range replicas.replicas {
+		replicas.checkHealth(ctx, replica, options.ReplicaHealthCheckInterval.Duration)
+	}
+
+	if len(replicas.replicas) > 0 {
+		var checkCtx context.Context
+
+		checkCtx, replicas.stop = context.WithCancel(context.WithoutCancel(ctx))
+
+		replicas.stopped.Add(1)
+
+		go replicas.checkHealthEvery(checkCtx, options.ReplicaHealthCheckInterval.Duration)
+	}
+
+	return replicas, nil
+}
+
+func (r *ReadReplicas) checkHealth(ctx context.Context, replica *readReplica, timeout time.Duration) {
+	logger := utils.GetLoggerFromContext(ctx)
+
+	database, err := replica.database.DB()
+	if err == nil {
+		pingCtx, cancel := context.WithTimeout(ctx, timeout)
+		err = database.PingContext(pingCtx)
+
+		cancel()
+	}
+
+	healthy := err == nil
+	if replica.healthy.Swap(healthy) != healthy {
+		if healthy {
+			logger.Infof("Read replica %s is healthy", replica.name)
+		} else {
+			logger.Warnf("Read replica %s is down, reading from the primary instead: %v", replica.name, err)
+		}
+	}
+}
+
+func (r *ReadReplicas) checkHealthEvery(ctx context.Context, interval time.Duration) {
+	defer r.stopped.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, replica := range r.replicas {
+				r.checkHealth(ctx, replica, interval)
+			}
+		}
+	}
+}
+
+// DB returns the database the reads of ctx go to.
+func (r *ReadReplicas) DB(ctx context.Context) *gorm.DB {
+	if utils.ReplicaReadsAllowed(ctx) {
+		for range r.replicas {
+			replica := r.replicas[r.next.Add(1)%uint64(len(r.replicas))]
+			if replica.healthy.Load() {
+				return replica.database.WithContext(ctx)
+			}
+		}
+	}
+
+	return r.primary.WithContext(ctx)
+}
+
+func (r *ReadReplicas) closeReplicas() error {
+	errs := make([]error, 0, len(r.replicas))
+	for _, replica := range r.replicas {
+		errs = append(errs, CloseDatabase(replica.database))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Close stops the health checks and closes the connections to the replicas, not to the primary.
+func (r *ReadReplicas) Close() error {
+	if r.stop != nil {
+		r.stop()
+		r.stopped.Wait()
+	}
+
+	return r.closeReplicas()
+}
@@ -0,0 +1,59 @@
+package sql
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+func requireDatabaseName(t *testing.T, database *gorm.DB, expected string) {
+	t.Helper()
+
+	var name string
+	require.NoError(t, database.Raw("SELECT name FROM database_name").Scan(&name).Error)
+	assert.Equal(t, expected, name)
+}
+
+func TestReadReplicas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	primary := newSqliteDatabase(t)
+	require.NoError(t, primary.Exec("CREATE TABLE database_name (name VARCHAR(10))").Error)
+	require.NoError(t, primary.Exec("INSERT INTO database_name VALUES ('primary')").Error)
+
+	replicaURI := "sqlite:///" + filepath.Join(t.TempDir(), "replica.db")
+
+	replicaDatabase, err := NewDatabase(ctx, replicaURI, config.DatabaseConfig{})
+	require.NoError(t, err)
+	require.NoError(t, replicaDatabase.Exec("CREATE TABLE database_name (name VARCHAR(10))").Error)
+	require.NoError(t, replicaDatabase.Exec("INSERT INTO database_name VALUES ('replica')").Error)
+	require.NoError(t, CloseDatabase(replicaDatabase))
+
+	replicas, err := NewReadReplicas(ctx, primary, []string{replicaURI}, config.DatabaseConfig{
+		ReplicaHealthCheckInterval: config.Duration{Duration: time.Hour},
+	})
+	require.NoError(t, err)
+
+	requireDatabaseName(t, replicas.DB(ctx), "primary")
+	requireDatabaseName(t, replicas.DB(utils.NewContextWithReplicaReads(ctx)), "replica")
+
+	// a replica failing its health check is skipped until it answers again.
+	replica := replicas.replicas[0]
+	require.NoError(t, CloseDatabase(replica.database))
+	replicas.checkHealth(ctx, replica, time.Second)
+
+	assert.False(t, replica.healthy.Load())
+	requireDatabaseName(t, replicas.DB(utils.NewContextWithReplicaReads(ctx)), "primary")
+
+	require.NoError(t, replicas.Close())
+}
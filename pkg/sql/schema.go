@@ -0,0 +1,335 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// columnType is the type of a column, rendered for each dialect like SQLAlchemy renders the
+// types of the MLflow migrations.
+type columnType int
+
+const (
+	integerColumn columnType = iota
+	bigIntegerColumn
+	floatColumn
+	booleanColumn
+	stringColumn
+	textColumn
+	// mediumTextColumn is TEXT, but MEDIUMTEXT on MySQL.
+	mediumTextColumn
+)
+
+type column struct {
+	name string
+	kind columnType
+	// size is the length of string columns.
+	size    int
+	notNull bool
+	// defaultValue is the server default of the column, "0" or "1" for booleans.
+	defaultValue  string
+	autoIncrement bool
+}
+
+func integer(name string) column       { return column{name: name, kind: integerColumn} }
+func bigInteger(name string) column    { return column{name: name, kind: bigIntegerColumn} }
+func float(name string) column         { return column{name: name, kind: floatColumn} }
+func boolean(name string) column       { return column{name: name, kind: booleanColumn} }
+func text(name string) column          { return column{name: name, kind: textColumn} }
+func mediumText(name string) column    { return column{name: name, kind: mediumTextColumn} }
+func str(name string, size int) column { return column{name: name, kind: stringColumn, size: size} }
+
+func (c column) required() column {
+	c.notNull = true
+
+	return c
+}
+
+func (c column) withDefault(value string) column {
+	c.defaultValue = value
+
+	return c
+}
+
+//nolint:cyclop
+func (c column) typeName(dialect string) string {
+	switch c.kind {
+	case integerColumn:
+		if c.autoIncrement && dialect == "postgres" {
+			return "SERIAL"
+		}
+
+		return "INTEGER"
+	case bigIntegerColumn:
+		return "BIGINT"
+	case floatColumn:
+		if dialect == "sqlite" {
+			return "FLOAT"
+		}
+
+		return "FLOAT(53)"
+	case booleanColumn:
+		switch dialect {
+		case "mysql":
+			return "BOOL"
+		case "sqlserver":
+			return "BIT"
+		default:
+			return "BOOLEAN"
+		}
+	case stringColumn:
+		return fmt.Sprintf("VARCHAR(%d)", c.size)
+	case mediumTextColumn:
+		if dialect == "mysql" {
+			return "MEDIUMTEXT"
+		}
+
+		fallthrough
+	case textColumn:
+		if dialect == "sqlserver" {
+			return "VARCHAR(max)"
+		}
+
+		return "TEXT"
+	default:
+		return ""
+	}
+}
+
+func (c column) definition(database *gorm.DB) string {
+	dialect := database.Dialector.Name()
+	parts := []string{database.Statement.Quote(c.name), c.typeName(dialect)}
+
+	if c.defaultValue != "" {
+		value := "'" + c.defaultValue + "'"
+		if c.kind == booleanColumn && dialect == "postgres" {
+			value = map[string]string{"0": "false", "1": "true"}[c.defaultValue]
+		}
+
+		parts = append(parts, "DEFAULT "+value)
+	}
+
+	if c.notNull {
+		parts = append(parts, "NOT NULL")
+	}
+
+	if c.autoIncrement {
+		switch dialect {
+		case "mysql":
+			parts = append(parts, "AUTO_INCREMENT")
+		case "sqlserver":
+			parts = append(parts, "IDENTITY(1,1)")
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// constraint is a constraint of a table, rendered as `CONSTRAINT name definition` when named.
+type constraint struct {
+	name       string
+	definition func(database *gorm.DB) string
+}
+
+func quoteColumns(database *gorm.DB, columns []string) string {
+	quoted := make([]string, 0, len(columns))
+	for _, column := range columns {
+		quoted = append(quoted, database.Statement.Quote(column))
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+func primaryKey(name string, columns ...string) constraint {
+	return constraint{name: name, definition: func(database *gorm.DB) string {
+		return fmt.Sprintf("PRIMARY KEY (%s)", quoteColumns(database, columns))
+	}}
+}
+
+func unique(columns ...string) constraint {
+	return constraint{definition: func(database *gorm.DB) string {
+		return fmt.Sprintf("UNIQUE (%s)", quoteColumns(database, columns))
+	}}
+}
+
+func check(name, condition string) constraint {
+	return constraint{name: name, definition: func(*gorm.DB) string {
+		return fmt.Sprintf("CHECK (%s)", condition)
+	}}
+}
+
+// foreignKey references the columns of the same name of table, actions being e.g.
+// `ON DELETE CASCADE`.
+func foreignKey(name string, columns []string, table string, actions ...string) constraint {
+	return foreignKeyTo(name, columns, table, columns, actions...)
+}
+
+// foreignKeyTo references the referenced columns of table.
+func foreignKeyTo(name string, columns []string, table string, referenced []string, actions ...string) constraint {
+	return constraint{name: name, definition: func(database *gorm.DB) string {
+		return strings.Join(append([]string{fmt.Sprintf(
+			"FOREIGN KEY(%s) REFERENCES %s (%s)",
+			quoteColumns(database, columns), database.Statement.Quote(table), quoteColumns(database, referenced),
+		)}, actions...), " ")
+	}}
+}
+
+func (c constraint) render(database *gorm.DB) string {
+	if c.name == "" {
+		return c.definition(database)
+	}
+
+	return fmt.Sprintf("CONSTRAINT %s %s", database.Statement.Quote(c.name), c.definition(database))
+}
+
+type index struct {
+	name    string
+	columns []string
+}
+
+type table struct {
+	name        string
+	columns     []column
+	constraints []constraint
+	indexes     []index
+}
+
+func (t *table) column(name string) (column, bool) {
+	for _, column := range t.columns {
+		if column.name == name {
+			return column, true
+		}
+	}
+
+	return column{}, false
+}
+
+func (t *table) createStatement(database *gorm.DB, name string) string {
+	definitions := make([]string, 0, len(t.columns)+len(t.constraints))
+
+	for _, column := range t.columns {
+		definitions = append(definitions, column.definition(database))
+	}
+
+	for _, constraint := range t.constraints {
+		definitions = append(definitions, constraint.render(database))
+	}
+
+	return fmt.Sprintf(
+		"CREATE TABLE %s (\n\t%s\n)", database.Statement.Quote(name), strings.Join(definitions, ",\n\t"),
+	)
+}
+
+func (t *table) createIndexes(database *gorm.DB) error {
+	for _, index := range t.indexes {
+		if err := database.Exec(fmt.Sprintf(
+			"CREATE INDEX %s ON %s (%s)",
+			database.Statement.Quote(index.name), database.Statement.Quote(t.name), quoteColumns(database, index.columns),
+		)).Error; err != nil {
+			return fmt.Errorf("failed to create index %s: %w", index.name, err)
+		}
+	}
+
+	return nil
+}
+
+func (t *table) create(database *gorm.DB) error {
+	if err := database.Exec(t.createStatement(database, t.name)).Error; err != nil {
+		return fmt.Errorf("failed to create table %s: %w", t.name, err)
+	}
+
+	return t.createIndexes(database)
+}
+
+func createTables(database *gorm.DB, tables ...*table) error {
+	for _, table := range tables {
+		if err := table.create(database); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addColumn adds the column of name of the definition of table.
+func addColumn(database *gorm.DB, table *table, name string) error {
+	column, ok := table.column(name)
+	if !ok {
+		return fmt.Errorf("%w: %s.%s", errUnknownColumn, table.name, name)
+	}
+
+	keyword := "ADD COLUMN"
+	if database.Dialector.Name() == "sqlserver" {
+		keyword = "ADD"
+	}
+
+	if err := database.Exec(fmt.Sprintf(
+		"ALTER TABLE %s %s %s", database.Statement.Quote(table.name), keyword, column.definition(database),
+	)).Error; err != nil {
+		return fmt.Errorf("failed to add column %s.%s: %w", table.name, name, err)
+	}
+
+	return nil
+}
+
+// alterColumnType changes the type of the column of name to the one of the definition of table.
+// SQLite cannot alter columns, so the table is recreated like Alembic does in batch mode.
+func alterColumnType(database *gorm.DB, table *table, name string) error {
+	column, ok := table.column(name)
+	if !ok {
+		return fmt.Errorf("%w: %s.%s", errUnknownColumn, table.name, name)
+	}
+
+	dialect := database.Dialector.Name()
+	quotedTable, quotedColumn := database.Statement.Quote(table.name), database.Statement.Quote(name)
+
+	var statement string
+
+	switch dialect {
+	case "sqlite":
+		return recreateTable(database, table)
+	case "postgres":
+		statement = fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s", quotedTable, quotedColumn, column.typeName(dialect))
+	case "mysql":
+		statement = fmt.Sprintf("ALTER TABLE %s MODIFY %s", quotedTable, column.definition(database))
+	default:
+		statement = fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s", quotedTable, column.definition(database))
+	}
+
+	if err := database.Exec(statement).Error; err != nil {
+		return fmt.Errorf("failed to alter column %s.%s: %w", table.name, name, err)
+	}
+
+	return nil
+}
+
+// recreateTable replaces table by one created from its definition, copying its rows.
+func recreateTable(database *gorm.DB, table *table) error {
+	temporary := "_alembic_tmp_" + table.name
+
+	columns := make([]string, 0, len(table.columns))
+	for _, column := range table.columns {
+		columns = append(columns, column.name)
+	}
+
+	for _, statement := range []string{
+		table.createStatement(database, temporary),
+		fmt.Sprintf(
+			"INSERT INTO %s (%s) SELECT %s FROM %s",
+			database.Statement.Quote(temporary), quoteColumns(database, columns),
+			quoteColumns(database, columns), database.Statement.Quote(table.name),
+		),
+		"DROP TABLE " + database.Statement.Quote(table.name),
+		fmt.Sprintf(
+			"ALTER TABLE %s RENAME TO %s", database.Statement.Quote(temporary), database.Statement.Quote(table.name),
+		),
+	} {
+		if err := database.Exec(statement).Error; err != nil {
+			return fmt.Errorf("failed to recreate table %s: %w", table.name, err)
+		}
+	}
+
+	return table.createIndexes(database)
+}
@@ -0,0 +1,81 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// The tables below belong to the Go server rather than to MLflow, so they are not part of its
+// Alembic revisions. CreateServerTables creates them when they are missing.
+
+var idempotencyKeysTable = &table{
+	name: "idempotency_keys",
+	columns: []column{
+		str("idempotency_key", 255).required(),
+		str("request_hash", 64).required(),
+		integer("response_status").required().withDefault("0"),
+		mediumText("response_body"),
+		bigInteger("creation_time").required(),
+	},
+	constraints: []constraint{primaryKey("idempotency_key_pk", "idempotency_key")},
+	indexes:     []index{{name: "index_idempotency_keys_creation_time", columns: []string{"creation_time"}}},
+}
+
+// runHeartbeatsTable has no foreign key to runs, for MLflow to garbage collect runs without
+// knowing about it.
+var runHeartbeatsTable = &table{
+	name: "run_heartbeats",
+	columns: []column{
+		str("run_uuid", 32).required(),
+		bigInteger("last_heartbeat").required(),
+	},
+	constraints: []constraint{primaryKey("run_heartbeat_pk", "run_uuid")},
+	indexes:     []index{{name: "index_run_heartbeats_last_heartbeat", columns: []string{"last_heartbeat"}}},
+}
+
+var serverTables = []*table{idempotencyKeysTable, runHeartbeatsTable}
+
+// createMissingIndexes creates the indexes added to serverTable after it was created.
+func createMissingIndexes(ctx context.Context, database *gorm.DB, serverTable *table) error {
+	for _, missing := range serverTable.indexes {
+		if database.Migrator().HasIndex(serverTable.name, missing.name) {
+			continue
+		}
+
+		utils.GetLoggerFromContext(ctx).Infof("Creating index %s", missing.name)
+
+		if err := (&table{name: serverTable.name, indexes: []index{missing}}).createIndexes(database); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateServerTables creates the tables, and indexes, of the Go server missing from the tracking
+// store database.
+func CreateServerTables(ctx context.Context, database *gorm.DB) error {
+	database = database.WithContext(ctx)
+
+	for _, table := range serverTables {
+		if database.Migrator().HasTable(table.name) {
+			if err := createMissingIndexes(ctx, database, table); err != nil {
+				return fmt.Errorf("failed to create server tables: %w", err)
+			}
+
+			continue
+		}
+
+		utils.GetLoggerFromContext(ctx).Infof("Creating table %s", table.name)
+
+		if err := Transaction(database, table.create); err != nil {
+			return fmt.Errorf("failed to create server tables: %w", err)
+		}
+	}
+
+	return nil
+}
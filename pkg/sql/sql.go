@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"net/url"
 	"runtime"
+	"strconv"
 	"strings"
+	"time"
 
 	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
@@ -14,6 +16,7 @@ import (
 	"gorm.io/driver/sqlserver"
 	"gorm.io/gorm"
 
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
 	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
 
@@ -66,6 +69,48 @@ func getDialector(uri *url.URL) (gorm.Dialector, error) {
 	}
 }
 
+// setStatementTimeout makes the database of uri abort the statements running longer than timeout.
+func setStatementTimeout(uri *url.URL, timeout time.Duration) {
+	scheme, _, _ := strings.Cut(uri.Scheme, "+")
+	query := uri.Query()
+
+	switch scheme {
+	case "postgres", "postgresql":
+		query.Set("statement_timeout", strconv.FormatInt(timeout.Milliseconds(), 10))
+	case "mysql":
+		query.Set("max_execution_time", strconv.FormatInt(timeout.Milliseconds(), 10))
+	default:
+		return
+	}
+
+	uri.RawQuery = query.Encode()
+}
+
+func configurePool(database *gorm.DB, options config.DatabaseConfig) error {
+	sqlDB, err := database.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database instance: %w", err)
+	}
+
+	if options.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(options.MaxOpenConns)
+	}
+
+	if options.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(options.MaxIdleConns)
+	}
+
+	if options.ConnMaxLifetime.Duration > 0 {
+		sqlDB.SetConnMaxLifetime(options.ConnMaxLifetime.Duration)
+	}
+
+	if options.ConnMaxIdleTime.Duration > 0 {
+		sqlDB.SetConnMaxIdleTime(options.ConnMaxIdleTime.Duration)
+	}
+
+	return nil
+}
+
 func initSqlite(database *gorm.DB) error {
 	database.Exec("PRAGMA case_sensitive_like = true;")
 
@@ -80,7 +125,12 @@ func initSqlite(database *gorm.DB) error {
 	return nil
 }
 
-func NewDatabase(ctx context.Context, storeURL string) (*gorm.DB, error) {
+func NewDatabase(ctx context.Context, storeURL string, options config.DatabaseConfig) (*gorm.DB, error) {
+	return openDatabase(ctx, storeURL, options, false)
+}
+
+// openDatabase opens the database of storeURL, without checking that it answers when lazy.
+func openDatabase(ctx context.Context, storeURL string, options config.DatabaseConfig, lazy bool) (*gorm.DB, error) {
 	logger := utils.GetLoggerFromContext(ctx)
 
 	uri, err := url.Parse(storeURL)
@@ -88,19 +138,32 @@ func NewDatabase(ctx context.Context, storeURL string) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to parse store URL %q: %w", storeURL, err)
 	}
 
+	if options.StatementTimeout.Duration > 0 {
+		setStatementTimeout(uri, options.StatementTimeout.Duration)
+	}
+
 	dialector, err := getDialector(uri)
 	if err != nil {
 		return nil, err
 	}
 
 	database, err := gorm.Open(dialector, &gorm.Config{
-		TranslateError: true,
-		Logger:         NewLoggerAdaptor(logger, LoggerAdaptorConfig{IgnoreRecordNotFoundError: true}),
+		TranslateError:       true,
+		DisableAutomaticPing: lazy,
+		Logger:               NewLoggerAdaptor(logger, LoggerAdaptorConfig{IgnoreRecordNotFoundError: true}),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database %q: %w", uri.String(), err)
 	}
 
+	if err := database.Use(&transactionRetry{options: options}); err != nil {
+		return nil, fmt.Errorf("failed to configure transaction retries: %w", err)
+	}
+
+	if err := configurePool(database, options); err != nil {
+		return nil, err
+	}
+
 	if dialector.Name() == "sqlite" {
 		if err := initSqlite(database); err != nil {
 			return nil, err
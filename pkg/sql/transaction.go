@@ -0,0 +1,118 @@
+package sql
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mattn/go-sqlite3"
+	mssql "github.com/microsoft/go-mssqldb"
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// transactionRetry is the gorm plugin holding how Transaction retries the transactions of a database.
+type transactionRetry struct {
+	options config.DatabaseConfig
+}
+
+const transactionRetryName = "mlflow:transaction_retry"
+
+func (*transactionRetry) Name() string {
+	return transactionRetryName
+}
+
+func (*transactionRetry) Initialize(*gorm.DB) error {
+	return nil
+}
+
+// backoff returns the delay before the retry following attempt, jittered to spread the retries of
+// transactions that failed together.
+func (r *transactionRetry) backoff(attempt int) time.Duration {
+	delay := r.options.RetryBackoff.Duration
+	for range attempt {
+		if delay >= r.options.MaxRetryBackoff.Duration {
+			break
+		}
+
+		delay *= 2
+	}
+
+	delay = min(delay, r.options.MaxRetryBackoff.Duration)
+
+	return delay/2 + rand.N(delay/2+1) //nolint:gosec
+}
+
+// isRetryable tells whether err aborted a transaction that can succeed when run again.
+func isRetryable(err error) bool {
+	var pgError *pgconn.PgError
+	if errors.As(err, &pgError) {
+		// serialization_failure and deadlock_detected.
+		return pgError.Code == "40001" || pgError.Code == "40P01"
+	}
+
+	var mysqlError *mysql.MySQLError
+	if errors.As(err, &mysqlError) {
+		// ER_LOCK_DEADLOCK and ER_LOCK_WAIT_TIMEOUT.
+		return mysqlError.Number == 1213 || mysqlError.Number == 1205
+	}
+
+	var sqliteError sqlite3.Error
+	if errors.As(err, &sqliteError) {
+		return sqliteError.Code == sqlite3.ErrBusy || sqliteError.Code == sqlite3.ErrLocked
+	}
+
+	var mssqlError mssql.Error
+	if errors.As(err, &mssqlError) {
+		// The transaction was chosen as the victim of a deadlock.
+		return mssqlError.Number == 1205
+	}
+
+	return false
+}
+
+// Transaction runs fn in a transaction of database like gorm.DB.Transaction, running it again in a
+// new transaction when it fails on a serialization failure, a deadlock or a busy SQLite database,
+// as configured by the options database was created with.
+func Transaction(database *gorm.DB, fn func(transaction *gorm.DB) error) error {
+	return transaction(database, fn, isRetryable)
+}
+
+// TransactionRetryingOn runs fn like Transaction, also running it again when it fails with one of
+// errs, e.g. gorm.ErrDuplicatedKey when fn allocates a key which a concurrent transaction took.
+func TransactionRetryingOn(database *gorm.DB, fn func(transaction *gorm.DB) error, errs ...error) error {
+	return transaction(database, fn, func(err error) bool {
+		return isRetryable(err) || slices.ContainsFunc(errs, func(target error) bool {
+			return errors.Is(err, target)
+		})
+	})
+}
+
+func transaction(database *gorm.DB, fn func(transaction *gorm.DB) error, retryable func(err error) bool) error {
+	retry, _ := database.Config.Plugins[transactionRetryName].(*transactionRetry)
+
+	for attempt := 0; ; attempt++ {
+		err := database.Transaction(fn)
+		if err == nil || retry == nil || attempt >= retry.options.MaxRetries || !retryable(err) {
+			return err //nolint:wrapcheck
+		}
+
+		ctx := database.Statement.Context
+		backoff := retry.backoff(attempt)
+
+		utils.GetLoggerFromContext(ctx).Debugf(
+			"Retrying transaction in %s after attempt %d failed: %v", backoff, attempt+1, err,
+		)
+
+		select {
+		case <-ctx.Done():
+			return err //nolint:wrapcheck
+		case <-time.After(backoff):
+		}
+	}
+}
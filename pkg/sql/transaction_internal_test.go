@@ -0,0 +1,122 @@
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+)
+
+func TestIsRetryable(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		err       error
+		retryable bool
+	}{
+		{err: &pgconn.PgError{Code: "40001"}, retryable: true},
+		{err: fmt.Errorf("wrapped: %w", &pgconn.PgError{Code: "40P01"}), retryable: true},
+		{err: &pgconn.PgError{Code: "23505"}, retryable: false},
+		{err: sqlite3.Error{Code: sqlite3.ErrBusy}, retryable: true},
+		{err: gorm.ErrRecordNotFound, retryable: false},
+	} {
+		assert.Equal(t, test.retryable, isRetryable(test.err), test.err)
+	}
+}
+
+func TestTransactionRetries(t *testing.T) {
+	t.Parallel()
+
+	database := newSqliteDatabase(t)
+	database.Config.Plugins[transactionRetryName] = &transactionRetry{options: config.DatabaseConfig{
+		MaxRetries:      2,
+		RetryBackoff:    config.Duration{Duration: time.Millisecond},
+		MaxRetryBackoff: config.Duration{Duration: time.Millisecond},
+	}}
+
+	busy := sqlite3.Error{Code: sqlite3.ErrBusy}
+
+	for _, test := range []struct {
+		name     string
+		failures []error
+		err      error
+		attempts int
+	}{
+		{name: "succeeds after retries", failures: []error{busy, busy}, attempts: 3},
+		{name: "gives up after max retries", failures: []error{busy, busy, busy}, err: busy, attempts: 3},
+		{name: "does not retry other errors", failures: []error{gorm.ErrInvalidData}, err: gorm.ErrInvalidData, attempts: 1},
+	} {
+		attempts := 0
+		err := Transaction(database, func(*gorm.DB) error {
+			attempts++
+			if attempts <= len(test.failures) {
+				return test.failures[attempts-1]
+			}
+
+			return nil
+		})
+
+		if test.err == nil {
+			require.NoError(t, err, test.name)
+		} else {
+			require.True(t, errors.Is(err, test.err), test.name)
+		}
+
+		assert.Equal(t, test.attempts, attempts, test.name)
+	}
+}
+
+func TestTransactionRetryingOn(t *testing.T) {
+	t.Parallel()
+
+	database := newSqliteDatabase(t)
+	database.Config.Plugins[transactionRetryName] = &transactionRetry{options: config.DatabaseConfig{
+		MaxRetries:      2,
+		RetryBackoff:    config.Duration{Duration: time.Millisecond},
+		MaxRetryBackoff: config.Duration{Duration: time.Millisecond},
+	}}
+
+	attempts := 0
+	err := TransactionRetryingOn(database, func(*gorm.DB) error {
+		attempts++
+
+		return fmt.Errorf("failed to create model version: %w", gorm.ErrDuplicatedKey)
+	}, gorm.ErrDuplicatedKey)
+	require.ErrorIs(t, err, gorm.ErrDuplicatedKey)
+	assert.Equal(t, 3, attempts)
+
+	attempts = 0
+	err = TransactionRetryingOn(database, func(*gorm.DB) error {
+		attempts++
+
+		return gorm.ErrInvalidData
+	}, gorm.ErrDuplicatedKey)
+	require.ErrorIs(t, err, gorm.ErrInvalidData)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestTransactionRetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	retry := &transactionRetry{options: config.DatabaseConfig{
+		RetryBackoff:    config.Duration{Duration: 100 * time.Millisecond},
+		MaxRetryBackoff: config.Duration{Duration: time.Second},
+	}}
+
+	for attempt, maxBackoff := range []time.Duration{
+		100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second,
+		time.Second,
+	} {
+		backoff := retry.backoff(attempt)
+		assert.GreaterOrEqual(t, backoff, maxBackoff/2)
+		assert.LessOrEqual(t, backoff, maxBackoff)
+	}
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository"
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/resolver"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// listArtifactsPageSize is the number of files returned per page of ListArtifacts.
+const listArtifactsPageSize = 1000
+
+// listArtifactsPageToken is the offset of the next page in the listing of the directory which the
+// hash identifies, see listArtifactsHash.
+type listArtifactsPageToken struct {
+	Offset int    `json:"offset"`
+	Hash   string `json:"hash"`
+}
+
+// listArtifactsHash identifies the listing of a directory of a run, so that a page token cannot be
+// used to list another one.
+func listArtifactsHash(runID, artifactPath string) string {
+	sum := sha256.Sum256([]byte(runID + "\x00" + artifactPath))
+
+	return hex.EncodeToString(sum[:8])
+}
+
+func decodeListArtifactsPageToken(pageToken, hash string) (int, *contract.Error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+
+	var token listArtifactsPageToken
+	if err := json.NewDecoder(
+		base64.NewDecoder(base64.StdEncoding, strings.NewReader(pageToken)),
+	).Decode(&token); err != nil || token.Offset < 0 {
+		return 0, contract.NewErrorWith(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Invalid page token: %q", pageToken),
+			err,
+		)
+	}
+
+	if token.Hash != hash {
+		return 0, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"Invalid page token: it was issued for a different run_id or path",
+		)
+	}
+
+	return token.Offset, nil
+}
+
+func encodeListArtifactsPageToken(offset int, hash string) (string, *contract.Error) {
+	var token strings.Builder
+
+	// the encoder is closed to flush the last, partial, block.
+	encoder := base64.NewEncoder(base64.StdEncoding, &token)
+	if err := errors.Join(
+		json.NewEncoder(encoder).Encode(listArtifactsPageToken{Offset: offset, Hash: hash}), encoder.Close(),
+	); err != nil {
+		return "", contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "error encoding 'nextPageToken' value", err)
+	}
+
+	return token.String(), nil
+}
+
+// ListArtifacts lists the artifacts of a run below path. Paths in the response are relative
+// to the root artifact URI of the run.
+//
+//nolint:cyclop
+func (ts TrackingService) ListArtifacts(
+	ctx context.Context, input *protos.ListArtifacts,
+) (*protos.ListArtifacts_Response, *contract.Error) {
+	runID := input.GetRunId()
+	if runID == "" {
+		runID = input.GetRunUuid()
+	}
+
+	if runID == "" {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"Missing value for required parameter 'run_id'",
+		)
+	}
+
+	// paths escaping the root artifact URI of the run are rejected before anything is looked up.
+	artifactPath, err := repository.ValidatePath(input.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	hash := listArtifactsHash(runID, artifactPath)
+
+	offset, err := decodeListArtifactsPageToken(input.GetPageToken(), hash)
+	if err != nil {
+		return nil, err
+	}
+
+	artifactURI, err := ts.Store.GetRunArtifactURI(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	artifactRepository, factoryErr := ts.ArtifactRepositoryFactory(artifactURI)
+	if factoryErr != nil {
+		// the Python server lists the artifacts the Go server cannot reach, e.g. in Google Cloud Storage.
+		if errors.Is(factoryErr, resolver.ErrUnsupportedURI) {
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_NOT_IMPLEMENTED,
+				fmt.Sprintf("Listing artifacts stored at %q is not supported", artifactURI),
+				fmt.Errorf("%w: %w", contract.ErrFallThrough, factoryErr),
+			)
+		}
+
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to create artifact repository", factoryErr)
+	}
+
+	files, err := artifactRepository.ListArtifacts(ctx, artifactPath)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.ListArtifacts_Response{
+		RootUri: &artifactURI,
+		Files:   make([]*protos.FileInfo, 0, min(len(files), listArtifactsPageSize)),
+	}
+
+	end := min(offset+listArtifactsPageSize, len(files))
+	for _, file := range files[min(offset, end):end] {
+		response.Files = append(response.Files, file.ToProto())
+	}
+
+	if end < len(files) {
+		nextPageToken, err := encodeListArtifactsPageToken(end, hash)
+		if err != nil {
+			return nil, err
+		}
+
+		response.NextPageToken = &nextPageToken
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,157 @@
+package service //nolint:testpackage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository"
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/local"
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/resolver"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// listOnlyRepository returns a fixed listing, regardless of the path.
+type listOnlyRepository struct {
+	repository.ArtifactRepository
+	files []*entities.FileInfo
+}
+
+func (r listOnlyRepository) ListArtifacts(context.Context, string) ([]*entities.FileInfo, *contract.Error) {
+	return r.files, nil
+}
+
+func TestListArtifacts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	root := t.TempDir()
+
+	artifactRepository, err := local.NewLocalArtifactRepository(root)
+	require.NoError(t, err)
+	require.Nil(t, artifactRepository.UploadArtifact(ctx, "model/MLmodel", strings.NewReader("flavors: {}")))
+	require.Nil(t, artifactRepository.UploadArtifact(ctx, "model/data/weights.bin", strings.NewReader("0123")))
+
+	trackingStore := store.NewMockTrackingStore(t)
+	trackingStore.EXPECT().GetRunArtifactURI(ctx, "run").Return(root, nil)
+
+	service := TrackingService{
+		Store: trackingStore,
+		ArtifactRepositoryFactory: func(artifactURI string) (repository.ArtifactRepository, error) {
+			return resolver.NewArtifactRepository(artifactURI, "")
+		},
+	}
+
+	response, contractError := service.ListArtifacts(ctx, &protos.ListArtifacts{
+		RunUuid: utils.PtrTo("run"),
+		Path:    utils.PtrTo("model"),
+	})
+	require.Nil(t, contractError)
+	assert.Equal(t, root, response.GetRootUri())
+	assert.Empty(t, response.GetNextPageToken())
+	require.Len(t, response.GetFiles(), 2)
+	assert.Equal(t, "model/MLmodel", response.GetFiles()[0].GetPath())
+	assert.Equal(t, int64(11), response.GetFiles()[0].GetFileSize())
+	assert.Equal(t, "model/data", response.GetFiles()[1].GetPath())
+	assert.True(t, response.GetFiles()[1].GetIsDir())
+	assert.Nil(t, response.GetFiles()[1].FileSize)
+}
+
+func TestListArtifactsPagination(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	files := make([]*entities.FileInfo, 0, listArtifactsPageSize+1)
+	for i := range listArtifactsPageSize + 1 {
+		files = append(files, &entities.FileInfo{Path: fmt.Sprintf("file-%04d", i)})
+	}
+
+	trackingStore := store.NewMockTrackingStore(t)
+	trackingStore.EXPECT().GetRunArtifactURI(ctx, "run").Return("s3://bucket/run", nil)
+
+	service := TrackingService{
+		Store: trackingStore,
+		ArtifactRepositoryFactory: func(string) (repository.ArtifactRepository, error) {
+			return listOnlyRepository{files: files}, nil
+		},
+	}
+
+	response, contractError := service.ListArtifacts(ctx, &protos.ListArtifacts{RunId: utils.PtrTo("run")})
+	require.Nil(t, contractError)
+	require.Len(t, response.GetFiles(), listArtifactsPageSize)
+	require.NotEmpty(t, response.GetNextPageToken())
+
+	pageToken := response.GetNextPageToken()
+
+	response, contractError = service.ListArtifacts(ctx, &protos.ListArtifacts{
+		RunId:     utils.PtrTo("run"),
+		PageToken: utils.PtrTo(pageToken),
+	})
+	require.Nil(t, contractError)
+	require.Len(t, response.GetFiles(), 1)
+	assert.Equal(t, fmt.Sprintf("file-%04d", listArtifactsPageSize), response.GetFiles()[0].GetPath())
+	assert.Empty(t, response.GetNextPageToken())
+
+	// the page token is bound to the run and the path it was issued for.
+	for name, input := range map[string]*protos.ListArtifacts{
+		"OtherRun":  {RunId: utils.PtrTo("other-run"), PageToken: utils.PtrTo(pageToken)},
+		"OtherPath": {RunId: utils.PtrTo("run"), Path: utils.PtrTo("model"), PageToken: utils.PtrTo(pageToken)},
+	} {
+		_, contractError = service.ListArtifacts(ctx, input)
+		require.NotNil(t, contractError, name)
+		assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractError.Code), name)
+	}
+}
+
+func TestListArtifactsUnsupportedURIFallsThrough(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	trackingStore := store.NewMockTrackingStore(t)
+	trackingStore.EXPECT().GetRunArtifactURI(ctx, "run").Return("gs://bucket/run", nil)
+
+	service := TrackingService{
+		Store: trackingStore,
+		ArtifactRepositoryFactory: func(artifactURI string) (repository.ArtifactRepository, error) {
+			return resolver.NewArtifactRepository(artifactURI, "")
+		},
+	}
+
+	_, contractError := service.ListArtifacts(ctx, &protos.ListArtifacts{RunId: utils.PtrTo("run")})
+	require.NotNil(t, contractError)
+	require.ErrorIs(t, contractError, contract.ErrFallThrough)
+}
+
+func TestListArtifactsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	service := TrackingService{
+		Store: store.NewMockTrackingStore(t),
+		ArtifactRepositoryFactory: func(string) (repository.ArtifactRepository, error) {
+			return nil, io.EOF
+		},
+	}
+
+	for name, input := range map[string]*protos.ListArtifacts{
+		"MissingRunID":     {Path: utils.PtrTo("model")},
+		"ParentDirectory":  {RunId: utils.PtrTo("run"), Path: utils.PtrTo("../other-run")},
+		"EncodedTraversal": {RunId: utils.PtrTo("run"), Path: utils.PtrTo("model/%2e%2e/%2e%2e")},
+		"AbsolutePath":     {RunId: utils.PtrTo("run"), Path: utils.PtrTo("/etc")},
+		"InvalidPageToken": {RunId: utils.PtrTo("run"), PageToken: utils.PtrTo("not a token")},
+	} {
+		_, contractError := service.ListArtifacts(context.Background(), input)
+		require.NotNil(t, contractError, name)
+		assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractError.Code), name)
+	}
+}
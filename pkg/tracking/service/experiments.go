@@ -12,6 +12,7 @@ import (
 	"github.com/mlflow/mlflow-go-backend/pkg/entities"
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
 
 // CreateExperiment implements TrackingService.
@@ -147,7 +148,7 @@ func (ts TrackingService) SearchExperiments(
 	ctx context.Context, input *protos.SearchExperiments,
 ) (*protos.SearchExperiments_Response, *contract.Error) {
 	experiments, nextPageToken, err := ts.Store.SearchExperiments(
-		ctx,
+		utils.NewContextWithReplicaReads(ctx),
 		input.GetViewType(),
 		input.GetMaxResults(),
 		input.GetFilter(),
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+func (ts TrackingService) ExplainSearchRuns(
+	ctx context.Context, input *protos.SearchRuns,
+) (*entities.SearchExplanation, *contract.Error) {
+	runViewType := protos.ViewType_ALL
+	if input.RunViewType != nil {
+		runViewType = input.GetRunViewType()
+	}
+
+	return ts.Store.ExplainSearchRuns(
+		ctx,
+		input.GetExperimentIds(),
+		input.GetFilter(),
+		runViewType,
+		int(input.GetMaxResults()),
+		input.GetOrderBy(),
+		input.GetPageToken(),
+	)
+}
+
+func (ts TrackingService) ExplainSearchExperiments(
+	ctx context.Context, input *protos.SearchExperiments,
+) (*entities.SearchExplanation, *contract.Error) {
+	return ts.Store.ExplainSearchExperiments(
+		ctx,
+		input.GetViewType(),
+		input.GetMaxResults(),
+		input.GetFilter(),
+		input.GetOrderBy(),
+		input.GetPageToken(),
+	)
+}
+
+func (ts TrackingService) ExplainSearchTraces(
+	ctx context.Context, input *protos.SearchTraces,
+) (*entities.SearchExplanation, *contract.Error) {
+	return ts.Store.ExplainSearchTraces(
+		ctx,
+		input.GetExperimentIds(),
+		input.GetFilter(),
+		int(input.GetMaxResults()),
+		input.GetOrderBy(),
+		input.GetPageToken(),
+	)
+}
@@ -0,0 +1,37 @@
+package service //nolint:testpackage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+func TestExplainSearchRunsDefaultsToAllRuns(t *testing.T) {
+	t.Parallel()
+
+	explanation := &entities.SearchExplanation{SQL: "SELECT * FROM runs", Params: []any{"1"}}
+
+	store := store.NewMockTrackingStore(t)
+	store.EXPECT().ExplainSearchRuns(
+		context.Background(), []string{"1"}, "params.a = 'b'", protos.ViewType_ALL, 10, []string(nil), "",
+	).Return(explanation, nil)
+
+	service := TrackingService{
+		Store: store,
+	}
+
+	output, err := service.ExplainSearchRuns(context.Background(), &protos.SearchRuns{
+		ExperimentIds: []string{"1"},
+		Filter:        utils.PtrTo("params.a = 'b'"),
+		MaxResults:    utils.PtrTo[int32](10),
+	})
+	require.Nil(t, err)
+	assert.Equal(t, explanation, output)
+}
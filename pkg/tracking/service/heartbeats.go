@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+func (ts TrackingService) RecordRunHeartbeat(ctx context.Context, runID string) *contract.Error {
+	if runID == "" {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"Missing value for required parameter 'run_id'",
+		)
+	}
+
+	return ts.Store.RecordRunHeartbeat(ctx, runID)
+}
+
+// RunReaper fails the runs which stopped sending heartbeats, and deletes the expired idempotency
+// keys, every ReapInterval of the heartbeat configuration until it is destroyed.
+type RunReaper struct {
+	stop    context.CancelFunc
+	stopped sync.WaitGroup
+}
+
+// StartRunReaper starts reaping the stale runs of the store in the background.
+func (ts TrackingService) StartRunReaper(ctx context.Context) *RunReaper {
+	reaper := &RunReaper{}
+
+	var reapCtx context.Context
+
+	reapCtx, reaper.stop = context.WithCancel(context.WithoutCancel(ctx))
+
+	reaper.stopped.Add(1)
+
+	go func() {
+		defer reaper.stopped.Done()
+
+		ticker := time.NewTicker(ts.config.RunHeartbeat.ReapInterval.Duration)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-reapCtx.Done():
+				return
+			case <-ticker.C:
+				ts.reapStaleRuns(reapCtx)
+				ts.deleteExpiredIdempotencyKeys(reapCtx)
+			}
+		}
+	}()
+
+	return reaper
+}
+
+func (ts TrackingService) reapStaleRuns(ctx context.Context) {
+	logger := utils.GetLoggerFromContext(ctx)
+
+	runIDs, err := ts.Store.ReapStaleRuns(ctx, ts.config.RunHeartbeat.Timeout.Duration)
+
+	switch {
+	case ctx.Err() != nil:
+		// the reaper was stopped while reaping.
+	case err != nil:
+		logger.Errorf("Failed to reap stale runs: %v", err)
+	case len(runIDs) > 0:
+		logger.Infof("Failed %d runs which stopped sending heartbeats: %s", len(runIDs), strings.Join(runIDs, ", "))
+	}
+}
+
+func (ts TrackingService) deleteExpiredIdempotencyKeys(ctx context.Context) {
+	deleted, err := ts.Store.DeleteExpiredIdempotencyKeys(ctx, ts.config.IdempotencyKeyTTL.Duration)
+
+	switch {
+	case ctx.Err() != nil:
+		// the reaper was stopped while deleting.
+	case err != nil:
+		utils.GetLoggerFromContext(ctx).Errorf("Failed to delete expired idempotency keys: %v", err)
+	case deleted > 0:
+		utils.GetLoggerFromContext(ctx).Debugf("Deleted %d expired idempotency keys", deleted)
+	}
+}
+
+// Destroy stops the reaper, waiting for the reaping in progress.
+func (r *RunReaper) Destroy() error {
+	r.stop()
+	r.stopped.Wait()
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package service //nolint:testpackage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store"
+)
+
+func TestRecordRunHeartbeatRequiresRunID(t *testing.T) {
+	t.Parallel()
+
+	service := TrackingService{Store: store.NewMockTrackingStore(t)}
+
+	err := service.RecordRunHeartbeat(context.Background(), "")
+	require.NotNil(t, err)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(err.Code))
+}
+
+func TestRunReaper(t *testing.T) {
+	t.Parallel()
+
+	reaped := make(chan struct{})
+
+	trackingStore := store.NewMockTrackingStore(t)
+	trackingStore.EXPECT().ReapStaleRuns(mock.Anything, 5*time.Minute).RunAndReturn(
+		func(context.Context, time.Duration) ([]string, *contract.Error) {
+			select {
+			case reaped <- struct{}{}:
+			default:
+			}
+
+			return []string{"run"}, nil
+		},
+	)
+	trackingStore.EXPECT().DeleteExpiredIdempotencyKeys(mock.Anything, time.Hour).Return(1, nil)
+
+	service := TrackingService{
+		config: &config.Config{
+			IdempotencyKeyTTL: config.Duration{Duration: time.Hour},
+			RunHeartbeat: config.RunHeartbeatConfig{
+				Timeout:      config.Duration{Duration: 5 * time.Minute},
+				ReapInterval: config.Duration{Duration: time.Millisecond},
+			},
+		},
+		Store: trackingStore,
+	}
+
+	reaper := service.StartRunReaper(context.Background())
+
+	select {
+	case <-reaped:
+	case <-time.After(time.Second):
+		t.Fatal("the stale runs were not reaped")
+	}
+
+	require.NoError(t, reaper.Destroy())
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+)
+
+func (ts TrackingService) ClaimIdempotencyKey(
+	ctx context.Context, key, requestHash string,
+) (*entities.IdempotentResponse, *contract.Error) {
+	return ts.Store.ClaimIdempotencyKey(ctx, key, requestHash, ts.config.IdempotencyKeyTTL.Duration)
+}
+
+func (ts TrackingService) SaveIdempotentResponse(
+	ctx context.Context, key string, status int, body []byte,
+) *contract.Error {
+	return ts.Store.SaveIdempotentResponse(ctx, key, status, body)
+}
+
+func (ts TrackingService) ReleaseIdempotencyKey(ctx context.Context, key string) *contract.Error {
+	return ts.Store.ReleaseIdempotencyKey(ctx, key)
+}
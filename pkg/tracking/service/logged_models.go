@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+func (ts TrackingService) CreateLoggedModel(
+	ctx context.Context, input *protos.CreateLoggedModel,
+) (*protos.CreateLoggedModel_Response, *contract.Error) {
+	loggedModel, err := ts.Store.CreateLoggedModel(
+		ctx,
+		input.GetExperimentId(),
+		input.GetName(),
+		input.ModelType,
+		input.SourceRunId,
+		entities.LoggedModelParamsFromProto(input.GetParams()),
+		entities.LoggedModelTagsFromProto(input.GetTags()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.CreateLoggedModel_Response{Model: loggedModel.ToProto()}, nil
+}
+
+func (ts TrackingService) FinalizeLoggedModel(
+	ctx context.Context, input *protos.FinalizeLoggedModel,
+) (*protos.FinalizeLoggedModel_Response, *contract.Error) {
+	if input.GetStatus() == protos.LoggedModelStatus_LOGGED_MODEL_STATUS_UNSPECIFIED {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"Missing value for required parameter 'status'.",
+		)
+	}
+
+	loggedModel, err := ts.Store.FinalizeLoggedModel(ctx, input.GetModelId(), input.GetStatus().String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.FinalizeLoggedModel_Response{Model: loggedModel.ToProto()}, nil
+}
+
+func (ts TrackingService) GetLoggedModel(
+	ctx context.Context, input *protos.GetLoggedModel,
+) (*protos.GetLoggedModel_Response, *contract.Error) {
+	loggedModel, err := ts.Store.GetLoggedModel(ctx, input.GetModelId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.GetLoggedModel_Response{Model: loggedModel.ToProto()}, nil
+}
+
+func (ts TrackingService) DeleteLoggedModel(
+	ctx context.Context, input *protos.DeleteLoggedModel,
+) (*protos.DeleteLoggedModel_Response, *contract.Error) {
+	if err := ts.Store.DeleteLoggedModel(ctx, input.GetModelId()); err != nil {
+		return nil, err
+	}
+
+	return &protos.DeleteLoggedModel_Response{}, nil
+}
+
+func (ts TrackingService) SetLoggedModelTags(
+	ctx context.Context, input *protos.SetLoggedModelTags,
+) (*protos.SetLoggedModelTags_Response, *contract.Error) {
+	if err := ts.Store.SetLoggedModelTags(
+		ctx, input.GetModelId(), entities.LoggedModelTagsFromProto(input.GetTags()),
+	); err != nil {
+		return nil, err
+	}
+
+	loggedModel, err := ts.Store.GetLoggedModel(ctx, input.GetModelId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.SetLoggedModelTags_Response{Model: loggedModel.ToProto()}, nil
+}
+
+func (ts TrackingService) DeleteLoggedModelTag(
+	ctx context.Context, input *protos.DeleteLoggedModelTag,
+) (*protos.DeleteLoggedModelTag_Response, *contract.Error) {
+	if err := ts.Store.DeleteLoggedModelTag(ctx, input.GetModelId(), input.GetTagKey()); err != nil {
+		return nil, err
+	}
+
+	return &protos.DeleteLoggedModelTag_Response{}, nil
+}
+
+func (ts TrackingService) LogLoggedModelParams(
+	ctx context.Context, input *protos.LogLoggedModelParamsRequest,
+) (*protos.LogLoggedModelParamsRequest_Response, *contract.Error) {
+	if err := ts.Store.LogLoggedModelParams(
+		ctx, input.GetModelId(), entities.LoggedModelParamsFromProto(input.GetParams()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &protos.LogLoggedModelParamsRequest_Response{}, nil
+}
+
+func (ts TrackingService) SearchLoggedModels(
+	ctx context.Context, input *protos.SearchLoggedModels,
+) (*protos.SearchLoggedModels_Response, *contract.Error) {
+	for _, dataset := range input.GetDatasets() {
+		if dataset.GetDatasetName() == "" {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				"dataset_name is required when specifying datasets.",
+			)
+		}
+	}
+
+	loggedModels, nextPageToken, err := ts.Store.SearchLoggedModels(
+		ctx,
+		input.GetExperimentIds(),
+		input.GetFilter(),
+		entities.LoggedModelDatasetsFromProto(input.GetDatasets()),
+		int(input.GetMaxResults()),
+		entities.LoggedModelOrderByFromProto(input.GetOrderBy()),
+		input.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.SearchLoggedModels_Response{
+		Models: make([]*protos.LoggedModel, 0, len(loggedModels)),
+	}
+
+	if nextPageToken != "" {
+		response.NextPageToken = &nextPageToken
+	}
+
+	for _, loggedModel := range loggedModels {
+		response.Models = append(response.Models, loggedModel.ToProto())
+	}
+
+	return &response, nil
+}
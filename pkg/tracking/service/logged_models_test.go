@@ -0,0 +1,74 @@
+package service //nolint:testpackage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+func TestCreateLoggedModel(t *testing.T) {
+	t.Parallel()
+
+	trackingStore := store.NewMockTrackingStore(t)
+	trackingStore.EXPECT().CreateLoggedModel(
+		context.Background(),
+		"1",
+		"model",
+		utils.PtrTo("agent"),
+		(*string)(nil),
+		[]*entities.LoggedModelParam{{Key: "alpha", Value: "0.5"}},
+		[]*entities.LoggedModelTag{{Key: "stage", Value: "dev"}},
+	).Return(&entities.LoggedModel{
+		ModelID:      "m-1",
+		ExperimentID: "1",
+		Name:         "model",
+		Status:       protos.LoggedModelStatus_LOGGED_MODEL_PENDING.String(),
+		Params:       []*entities.LoggedModelParam{{Key: "alpha", Value: "0.5"}},
+		Tags:         []*entities.LoggedModelTag{{Key: "stage", Value: "dev"}},
+		Metrics: []*entities.MetricWithRunID{
+			{Metric: entities.Metric{Key: "loss", Value: 0.1, DatasetName: "train", DatasetDigest: "abc"}, RunID: "run-1"},
+		},
+	}, nil)
+
+	service := TrackingService{Store: trackingStore}
+
+	response, err := service.CreateLoggedModel(context.Background(), &protos.CreateLoggedModel{
+		ExperimentId: utils.PtrTo("1"),
+		Name:         utils.PtrTo("model"),
+		ModelType:    utils.PtrTo("agent"),
+		Params:       []*protos.LoggedModelParameter{{Key: utils.PtrTo("alpha"), Value: utils.PtrTo("0.5")}},
+		Tags:         []*protos.LoggedModelTag{{Key: utils.PtrTo("stage"), Value: utils.PtrTo("dev")}},
+	})
+	require.Nil(t, err)
+
+	info := response.GetModel().GetInfo()
+	assert.Equal(t, "m-1", info.GetModelId())
+	assert.Equal(t, protos.LoggedModelStatus_LOGGED_MODEL_PENDING, info.GetStatus())
+	assert.Equal(t, "stage", info.GetTags()[0].GetKey())
+
+	data := response.GetModel().GetData()
+	assert.Equal(t, "alpha", data.GetParams()[0].GetKey())
+	assert.Equal(t, "run-1", data.GetMetrics()[0].GetRunId())
+	assert.Equal(t, "m-1", data.GetMetrics()[0].GetModelId())
+	assert.Equal(t, "train", data.GetMetrics()[0].GetDatasetName())
+}
+
+func TestFinalizeLoggedModelRequiresStatus(t *testing.T) {
+	t.Parallel()
+
+	service := TrackingService{Store: store.NewMockTrackingStore(t)}
+
+	_, err := service.FinalizeLoggedModel(context.Background(), &protos.FinalizeLoggedModel{
+		ModelId: utils.PtrTo("m-1"),
+		Status:  utils.PtrTo(protos.LoggedModelStatus_LOGGED_MODEL_STATUS_UNSPECIFIED),
+	})
+	require.NotNil(t, err)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(err.Code))
+}
@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/mlflow/mlflow-go-backend/pkg/contract"
 	"github.com/mlflow/mlflow-go-backend/pkg/entities"
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
 
 func (ts TrackingService) LogMetric(
@@ -38,7 +40,7 @@ func (ts TrackingService) GetMetricHistory(
 	}
 
 	metrics, nextPageToken, err := ts.Store.GetMetricHistory(
-		ctx,
+		utils.NewContextWithReplicaReads(ctx),
 		runID,
 		input.GetMetricKey(),
 		input.GetPageToken(),
@@ -62,3 +64,84 @@ func (ts TrackingService) GetMetricHistory(
 
 	return &response, nil
 }
+
+const (
+	maxRunIDsPerMetricHistoryRequest = 100
+	maxMetricHistoryResultsPerRun    = 2500
+)
+
+//nolint:cyclop
+func (ts TrackingService) GetMetricHistoryBulkInterval(
+	ctx context.Context, input *protos.GetMetricHistoryBulkInterval,
+) (*protos.GetMetricHistoryBulkInterval_Response, *contract.Error) {
+	runIDs := input.GetRunIds()
+
+	switch {
+	case input.GetMetricKey() == "":
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"GetMetricHistoryBulkInterval request must specify a metric_key.",
+		)
+	case len(runIDs) == 0:
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"GetMetricHistoryBulkInterval request must specify at least one run_id.",
+		)
+	case len(runIDs) > maxRunIDsPerMetricHistoryRequest:
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"GetMetricHistoryBulkInterval request cannot specify more than %d run_ids. Received %d run_ids.",
+				maxRunIDsPerMetricHistoryRequest, len(runIDs),
+			),
+		)
+	}
+
+	maxResults := maxMetricHistoryResultsPerRun
+	if input.MaxResults != nil {
+		maxResults = int(input.GetMaxResults())
+	}
+
+	if maxResults <= 0 || maxResults > maxMetricHistoryResultsPerRun {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("max_results must be between 1 and %d.", maxMetricHistoryResultsPerRun),
+		)
+	}
+
+	var startStep, endStep *int64
+
+	switch {
+	case input.StartStep == nil && input.EndStep == nil:
+	case input.StartStep == nil || input.EndStep == nil:
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"If either start step or end step are specified, both must be specified.",
+		)
+	case input.GetStartStep() > input.GetEndStep():
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"end_step must be greater than start_step.",
+		)
+	default:
+		startStep = utils.PtrTo(int64(input.GetStartStep()))
+		endStep = utils.PtrTo(int64(input.GetEndStep()))
+	}
+
+	metrics, err := ts.Store.GetMetricHistoryBulkInterval(
+		ctx, runIDs, input.GetMetricKey(), startStep, endStep, maxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.GetMetricHistoryBulkInterval_Response{
+		Metrics: make([]*protos.MetricWithRunId, len(metrics)),
+	}
+
+	for i, metric := range metrics {
+		response.Metrics[i] = metric.ToProto()
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,100 @@
+package service //nolint:testpackage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+func TestGetMetricHistoryBulkInterval(t *testing.T) {
+	t.Parallel()
+
+	trackingStore := store.NewMockTrackingStore(t)
+	trackingStore.EXPECT().GetMetricHistoryBulkInterval(
+		context.Background(),
+		[]string{"run-1", "run-2"},
+		"loss",
+		utils.PtrTo(int64(10)),
+		utils.PtrTo(int64(20)),
+		maxMetricHistoryResultsPerRun,
+	).Return([]*entities.MetricWithRunID{
+		{Metric: entities.Metric{Key: "loss", Value: 0.5, Step: 10}, RunID: "run-1"},
+	}, nil)
+
+	service := TrackingService{Store: trackingStore}
+
+	response, err := service.GetMetricHistoryBulkInterval(context.Background(), &protos.GetMetricHistoryBulkInterval{
+		RunIds:    []string{"run-1", "run-2"},
+		MetricKey: utils.PtrTo("loss"),
+		StartStep: utils.PtrTo(int32(10)),
+		EndStep:   utils.PtrTo(int32(20)),
+	})
+	require.Nil(t, err)
+	require.Len(t, response.GetMetrics(), 1)
+	assert.Equal(t, "run-1", response.GetMetrics()[0].GetRunId())
+	assert.Equal(t, int64(10), response.GetMetrics()[0].GetStep())
+}
+
+func TestGetMetricHistoryBulkIntervalInvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	tooManyRunIDs := make([]string, maxRunIDsPerMetricHistoryRequest+1)
+
+	tests := []struct {
+		name  string
+		input *protos.GetMetricHistoryBulkInterval
+	}{
+		{
+			name:  "MissingMetricKey",
+			input: &protos.GetMetricHistoryBulkInterval{RunIds: []string{"run-1"}},
+		},
+		{
+			name:  "MissingRunIDs",
+			input: &protos.GetMetricHistoryBulkInterval{MetricKey: utils.PtrTo("loss")},
+		},
+		{
+			name:  "TooManyRunIDs",
+			input: &protos.GetMetricHistoryBulkInterval{RunIds: tooManyRunIDs, MetricKey: utils.PtrTo("loss")},
+		},
+		{
+			name: "MaxResultsTooLarge",
+			input: &protos.GetMetricHistoryBulkInterval{
+				RunIds: []string{"run-1"}, MetricKey: utils.PtrTo("loss"), MaxResults: utils.PtrTo(int32(2501)),
+			},
+		},
+		{
+			name: "OnlyStartStep",
+			input: &protos.GetMetricHistoryBulkInterval{
+				RunIds: []string{"run-1"}, MetricKey: utils.PtrTo("loss"), StartStep: utils.PtrTo(int32(1)),
+			},
+		},
+		{
+			name: "StartAfterEnd",
+			input: &protos.GetMetricHistoryBulkInterval{
+				RunIds:    []string{"run-1"},
+				MetricKey: utils.PtrTo("loss"),
+				StartStep: utils.PtrTo(int32(5)),
+				EndStep:   utils.PtrTo(int32(1)),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := TrackingService{Store: store.NewMockTrackingStore(t)}
+
+			_, err := service.GetMetricHistoryBulkInterval(context.Background(), test.input)
+			require.NotNil(t, err)
+			assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(err.Code))
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package lexer
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Location points at a token of a filter in error messages.
+type Location struct {
+	// Offset is the byte offset of the token in the filter. Line and Column, from 1, are its
+	// position in lines and characters.
+	Offset int
+	Line   int
+	Column int
+	Token  string
+	// Snippet is the line of the filter holding the token, followed by a line of carets under it.
+	Snippet string
+}
+
+func NewLocation(source string, offset int, token string) *Location {
+	offset = min(max(offset, 0), len(source))
+
+	lineStart := strings.LastIndexByte(source[:offset], '\n') + 1
+
+	lineEnd := len(source)
+	if index := strings.IndexByte(source[offset:], '\n'); index >= 0 {
+		lineEnd = offset + index
+	}
+
+	// keep the tabs of the line, for the carets to line up with the token.
+	indent := strings.Map(func(char rune) rune {
+		if char == '\t' {
+			return char
+		}
+
+		return ' '
+	}, source[lineStart:offset])
+
+	return &Location{
+		Offset:  offset,
+		Line:    strings.Count(source[:lineStart], "\n") + 1,
+		Column:  utf8.RuneCountInString(source[lineStart:offset]) + 1,
+		Token:   token,
+		Snippet: source[lineStart:lineEnd] + "\n" + indent + strings.Repeat("^", max(utf8.RuneCountInString(token), 1)),
+	}
+}
+
+func (l *Location) String() string {
+	position := fmt.Sprintf("at column %d", l.Column)
+	if l.Line > 1 {
+		position = fmt.Sprintf("at line %d, column %d", l.Line, l.Column)
+	}
+
+	if l.Token == "" {
+		return fmt.Sprintf("%s:\n%s", position, l.Snippet)
+	}
+
+	return fmt.Sprintf("%s near %q:\n%s", position, l.Token, l.Snippet)
+}
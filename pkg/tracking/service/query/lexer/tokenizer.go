@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"unicode/utf8"
 )
 
 type regexPattern struct {
@@ -20,7 +21,8 @@ type lexer struct {
 }
 
 type Error struct {
-	message string
+	message  string
+	Location *Location
 }
 
 func NewLexerError(format string, a ...any) *Error {
@@ -31,6 +33,11 @@ func (e *Error) Error() string {
 	return e.message
 }
 
+// Locate returns the Location of the error, which Tokenize sets as it knows the source.
+func (e *Error) Locate(string) *Location {
+	return e.Location
+}
+
 func Tokenize(source *string) ([]Token, error) {
 	lex := createLexer(source)
 
@@ -49,11 +56,16 @@ func Tokenize(source *string) ([]Token, error) {
 		}
 
 		if !matched {
-			return lex.Tokens, NewLexerError("unrecognized token near '%v'", lex.remainder())
+			err := NewLexerError("unrecognized token near '%v'", lex.remainder())
+
+			char, _ := utf8.DecodeRuneInString(lex.remainder())
+			err.Location = NewLocation(*source, lex.pos, string(char))
+
+			return lex.Tokens, err
 		}
 	}
 
-	lex.push(newUniqueToken(EOF, "EOF"))
+	lex.push(newUniqueToken(EOF, "EOF", lex.pos))
 
 	return lex.Tokens, nil
 }
@@ -107,8 +119,8 @@ type regexHandler func(lex *lexer, regex *regexp.Regexp)
 // This handler is used with most simple tokens.
 func defaultHandler(kind TokenKind, value string) regexHandler {
 	return func(lex *lexer, _ *regexp.Regexp) {
+		lex.push(newUniqueToken(kind, value, lex.pos))
 		lex.advanceN(len(value))
-		lex.push(newUniqueToken(kind, value))
 	}
 }
 
@@ -116,13 +128,13 @@ func stringHandler(lex *lexer, regex *regexp.Regexp) {
 	match := regex.FindStringIndex(lex.remainder())
 	stringLiteral := lex.remainder()[match[0]:match[1]]
 
-	lex.push(newUniqueToken(String, stringLiteral))
+	lex.push(newUniqueToken(String, stringLiteral, lex.pos))
 	lex.advanceN(len(stringLiteral))
 }
 
 func numberHandler(lex *lexer, regex *regexp.Regexp) {
 	match := regex.FindString(lex.remainder())
-	lex.push(newUniqueToken(Number, match))
+	lex.push(newUniqueToken(Number, match, lex.pos))
 	lex.advanceN(len(match))
 }
 
@@ -131,9 +143,9 @@ func symbolHandler(lex *lexer, regex *regexp.Regexp) {
 	keyword := strings.ToUpper(match)
 
 	if kind, found := reservedLu[keyword]; found {
-		lex.push(newUniqueToken(kind, match))
+		lex.push(newUniqueToken(kind, match, lex.pos))
 	} else {
-		lex.push(newUniqueToken(Identifier, match))
+		lex.push(newUniqueToken(Identifier, match, lex.pos))
 	}
 
 	lex.advanceN(len(match))
@@ -1,6 +1,7 @@
 package lexer_test
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -112,3 +113,26 @@ func TestInvalidInput(t *testing.T) {
 		})
 	}
 }
+
+func TestLocation(t *testing.T) {
+	t.Parallel()
+
+	source := "tags.`é` = 'x' AND\n\tparams.b ~ 'y'"
+
+	_, err := lexer.Tokenize(&source)
+
+	var lexerError *lexer.Error
+	if !errors.As(err, &lexerError) || lexerError.Location == nil {
+		t.Fatalf("expected a located lexer error, got %v", err)
+	}
+
+	expected := "at line 2, column 11 near \"~\":\n\tparams.b ~ 'y'\n\t         ^"
+	if lexerError.Location.String() != expected {
+		t.Errorf("expected %q, got %q", expected, lexerError.Location.String())
+	}
+
+	location := lexer.NewLocation(source, strings.Index(source, "'x'"), "'x'")
+	if location.Line != 1 || location.Column != 12 {
+		t.Errorf("expected line 1, column 12 for a token after a multibyte character, got %#v", location)
+	}
+}
@@ -3,6 +3,8 @@ package parser
 import (
 	"fmt"
 	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/lexer"
 )
 
 // --------------------
@@ -55,6 +57,17 @@ func (n StringListExpr) String() string {
 	return strings.Join(items, ", ")
 }
 
+// NULL, the right hand side of IS NULL and IS NOT NULL.
+type NullExpr struct{}
+
+func (n NullExpr) value() interface{} {
+	return nil
+}
+
+func (n NullExpr) String() string {
+	return "NULL"
+}
+
 //-----------------------
 // Identifier Expressions
 // ----------------------
@@ -90,6 +103,8 @@ const (
 	ILike
 	In //nolint:varnamelen
 	NotIn
+	IsNull
+	IsNotNull
 )
 
 //nolint:cyclop
@@ -115,23 +130,93 @@ func (op OperatorKind) String() string {
 		return "IN"
 	case NotIn:
 		return "NOT IN"
+	case IsNull:
+		return "IS"
+	case IsNotNull:
+		return "IS NOT"
 	default:
 		return "UNKNOWN"
 	}
 }
 
+// IsNullCheck reports whether op is IS NULL or IS NOT NULL, which have no value to compare to.
+func (op OperatorKind) IsNullCheck() bool {
+	return op == IsNull || op == IsNotNull
+}
+
+// CompareTokens are the tokens a comparison was parsed from, which locate its validation errors.
+// For a key without identifier, Identifier is the token of the key. For a list, Value is the
+// opening parenthesis.
+type CompareTokens struct {
+	Identifier lexer.Token
+	Key        lexer.Token
+	Operator   lexer.Token
+	Value      lexer.Token
+}
+
 // a operator b.
 type CompareExpr struct {
 	Left     Identifier
 	Operator OperatorKind
 	Right    Value
+	Tokens   CompareTokens
 }
 
 func (expr *CompareExpr) String() string {
 	return fmt.Sprintf("%s %s %s", expr.Left, expr.Operator, expr.Right)
 }
 
+func (expr *CompareExpr) expr() {}
+
+// --------------------
+// Boolean Expressions
+// --------------------
+
+// Expr is a node of a filter: a comparison, or a boolean combination of filters.
+// Before validation the comparisons are *CompareExpr, after validation *ValidCompareExpr.
+type Expr interface {
+	fmt.Stringer
+	expr()
+}
+
+func joinExprs(exprs []Expr, separator string) string {
+	items := make([]string, 0, len(exprs))
+	for _, expr := range exprs {
+		items = append(items, expr.String())
+	}
+
+	return "(" + strings.Join(items, separator) + ")"
+}
+
 // AND.
 type AndExpr struct {
-	Exprs []*CompareExpr
+	Exprs []Expr
+}
+
+func (expr *AndExpr) String() string {
+	return joinExprs(expr.Exprs, " AND ")
+}
+
+func (expr *AndExpr) expr() {}
+
+// OR.
+type OrExpr struct {
+	Exprs []Expr
 }
+
+func (expr *OrExpr) String() string {
+	return joinExprs(expr.Exprs, " OR ")
+}
+
+func (expr *OrExpr) expr() {}
+
+// NOT.
+type NotExpr struct {
+	Expr Expr
+}
+
+func (expr *NotExpr) String() string {
+	return fmt.Sprintf("NOT (%s)", expr.Expr)
+}
+
+func (expr *NotExpr) expr() {}
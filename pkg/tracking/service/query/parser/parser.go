@@ -43,21 +43,48 @@ func (p *parser) advance() lexer.Token {
 }
 
 type Error struct {
-	message string
+	message  string
+	token    *lexer.Token
+	Location *lexer.Location
 }
 
 func NewParserError(format string, a ...any) *Error {
 	return &Error{message: fmt.Sprintf(format, a...)}
 }
 
+// errorf returns an error about the current token.
+func (p *parser) errorf(format string, a ...any) *Error {
+	token := p.currentToken()
+
+	return &Error{message: fmt.Sprintf(format, a...), token: &token}
+}
+
 func (e *Error) Error() string {
 	return e.message
 }
 
+// Locate sets and returns the Location of the error in source, the filter which was parsed.
+// It is not part of the message, as the error is wrapped by then.
+func (e *Error) Locate(source string) *lexer.Location {
+	if e.token != nil {
+		e.Location = tokenLocation(source, *e.token)
+	}
+
+	return e.Location
+}
+
+func tokenLocation(source string, token lexer.Token) *lexer.Location {
+	if token.Kind == lexer.EOF {
+		return lexer.NewLocation(source, token.Offset, "")
+	}
+
+	return lexer.NewLocation(source, token.Offset, token.Value)
+}
+
 func (p *parser) parseIdentifier() (Identifier, error) {
 	emptyIdentifier := Identifier{Identifier: "", Key: ""}
-	if p.hasTokens() && p.currentTokenKind() != lexer.Identifier {
-		return emptyIdentifier, NewParserError(
+	if p.currentTokenKind() != lexer.Identifier {
+		return emptyIdentifier, p.errorf(
 			"expected identifier, got %s",
 			p.printCurrentToken(),
 		)
@@ -79,7 +106,7 @@ func (p *parser) parseIdentifier() (Identifier, error) {
 
 			return Identifier{Identifier: identToken.Value, Key: column}, nil
 		default:
-			return emptyIdentifier, NewParserError(
+			return emptyIdentifier, p.errorf(
 				"expected IDENTIFIER or STRING, got %s",
 				p.printCurrentToken(),
 			)
@@ -90,27 +117,33 @@ func (p *parser) parseIdentifier() (Identifier, error) {
 }
 
 func (p *parser) parseOperator() (OperatorKind, error) {
+	var operator OperatorKind
+
 	//nolint:exhaustive
-	switch p.advance().Kind {
+	switch p.currentTokenKind() {
 	case lexer.Equals:
-		return Equals, nil
+		operator = Equals
 	case lexer.NotEquals:
-		return NotEquals, nil
+		operator = NotEquals
 	case lexer.Less:
-		return Less, nil
+		operator = Less
 	case lexer.LessEquals:
-		return LessEquals, nil
+		operator = LessEquals
 	case lexer.Greater:
-		return Greater, nil
+		operator = Greater
 	case lexer.GreaterEquals:
-		return GreaterEquals, nil
+		operator = GreaterEquals
 	case lexer.Like:
-		return Like, nil
+		operator = Like
 	case lexer.ILike:
-		return ILike, nil
+		operator = ILike
 	default:
-		return -1, NewParserError("expected operator, got %s", p.printCurrentToken())
+		return -1, p.errorf("expected operator, got %s", p.printCurrentToken())
 	}
+
+	p.advance() // Consume the operator
+
+	return operator, nil
 }
 
 //nolint:ireturn
@@ -130,7 +163,7 @@ func (p *parser) parseValue() (Value, error) {
 
 		return StringExpr{Value: value}, nil
 	default:
-		return nil, NewParserError(
+		return nil, p.errorf(
 			"Expected NUMBER or STRING, got %s",
 			p.printCurrentToken(),
 		)
@@ -139,7 +172,7 @@ func (p *parser) parseValue() (Value, error) {
 
 func (p *parser) parseInSetExpr(ident Identifier) (*CompareExpr, error) {
 	if p.currentTokenKind() != lexer.OpenParen {
-		return nil, NewParserError(
+		return nil, p.errorf(
 			"expected '(', got %s",
 			p.printCurrentToken(),
 		)
@@ -151,7 +184,7 @@ func (p *parser) parseInSetExpr(ident Identifier) (*CompareExpr, error) {
 
 	for p.hasTokens() && p.currentTokenKind() != lexer.CloseParen {
 		if p.currentTokenKind() != lexer.String {
-			return nil, NewParserError(
+			return nil, p.errorf(
 				"expected STRING, got %s",
 				p.printCurrentToken(),
 			)
@@ -168,7 +201,7 @@ func (p *parser) parseInSetExpr(ident Identifier) (*CompareExpr, error) {
 	}
 
 	if p.currentTokenKind() != lexer.CloseParen {
-		return nil, NewParserError(
+		return nil, p.errorf(
 			"expected ')', got %s",
 			p.printCurrentToken(),
 		)
@@ -179,23 +212,62 @@ func (p *parser) parseInSetExpr(ident Identifier) (*CompareExpr, error) {
 	return &CompareExpr{Left: ident, Operator: In, Right: StringListExpr{Values: set}}, nil
 }
 
+// parseNullExpr parses the remainder of `IS NULL` and `IS NOT NULL`.
+func (p *parser) parseNullExpr(ident Identifier) (*CompareExpr, error) {
+	operator := IsNull
+
+	if p.currentTokenKind() == lexer.Not {
+		p.advance() // Consume the NOT
+
+		operator = IsNotNull
+	}
+
+	if p.currentTokenKind() != lexer.Null {
+		return nil, p.errorf(
+			"expected NULL, got %s",
+			p.printCurrentToken(),
+		)
+	}
+
+	p.advance() // Consume the NULL
+
+	return &CompareExpr{Left: ident, Operator: operator, Right: NullExpr{}}, nil
+}
+
+//nolint:funlen
 func (p *parser) parseExpression() (*CompareExpr, error) {
+	tokens := CompareTokens{Identifier: p.currentToken()}
+
 	ident, err := p.parseIdentifier()
 	if err != nil {
 		return nil, err
 	}
 
+	tokens.Key = p.tokens[p.pos-1]
+	tokens.Operator = p.currentToken()
+
+	var expr *CompareExpr
+
 	//nolint:exhaustive
 	switch p.currentTokenKind() {
 	case lexer.In:
 		p.advance() // Consume the IN
 
-		return p.parseInSetExpr(ident)
+		tokens.Value = p.currentToken()
+
+		expr, err = p.parseInSetExpr(ident)
+	case lexer.Is:
+		p.advance() // Consume the IS
+
+		expr, err = p.parseNullExpr(ident)
+		if err == nil {
+			tokens.Value = p.tokens[p.pos-1]
+		}
 	case lexer.Not:
 		p.advance() // Consume the NOT
 
 		if p.currentTokenKind() != lexer.In {
-			return nil, NewParserError(
+			return nil, p.errorf(
 				"expected IN after NOT, got %s",
 				p.printCurrentToken(),
 			)
@@ -203,59 +275,171 @@ func (p *parser) parseExpression() (*CompareExpr, error) {
 
 		p.advance() // Consume the IN
 
-		expr, err := p.parseInSetExpr(ident)
+		tokens.Value = p.currentToken()
+
+		expr, err = p.parseInSetExpr(ident)
+		if err == nil {
+			expr.Operator = NotIn
+		}
+	default:
+		var operator OperatorKind
+
+		operator, err = p.parseOperator()
 		if err != nil {
 			return nil, err
 		}
 
-		expr.Operator = NotIn
+		tokens.Value = p.currentToken()
 
-		return expr, nil
-	default:
-		operator, err := p.parseOperator()
+		var value Value
+
+		value, err = p.parseValue()
+		if err == nil {
+			expr = &CompareExpr{Left: ident, Operator: operator, Right: value}
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	expr.Tokens = tokens
+
+	return expr, nil
+}
+
+// parseUnaryExpression parses a comparison, a negated filter or a filter in parentheses.
+//
+//nolint:ireturn
+func (p *parser) parseUnaryExpression() (Expr, error) {
+	//nolint:exhaustive
+	switch p.currentTokenKind() {
+	case lexer.Not:
+		p.advance() // Consume the NOT
+
+		expr, err := p.parseUnaryExpression()
 		if err != nil {
 			return nil, err
 		}
 
-		value, err := p.parseValue()
+		return &NotExpr{Expr: expr}, nil
+	case lexer.OpenParen:
+		p.advance() // Consume the OPEN_PAREN
+
+		expr, err := p.parseOrExpression()
 		if err != nil {
 			return nil, err
 		}
 
-		return &CompareExpr{Left: ident, Operator: operator, Right: value}, nil
+		if p.currentTokenKind() != lexer.CloseParen {
+			return nil, p.errorf(
+				"expected ')', got %s",
+				p.printCurrentToken(),
+			)
+		}
+
+		p.advance() // Consume the CLOSE_PAREN
+
+		return expr, nil
+	default:
+		return p.parseExpression()
 	}
 }
 
-func (p *parser) parse() (*AndExpr, error) {
-	exprs := make([]*CompareExpr, 0)
-
-	leftExpr, err := p.parseExpression()
+// parseAndExpression parses filters separated by AND, which binds tighter than OR.
+//
+//nolint:ireturn
+func (p *parser) parseAndExpression() (Expr, error) {
+	expr, err := p.parseUnaryExpression()
 	if err != nil {
-		return nil, fmt.Errorf("error while parsing initial expression: %w", err)
+		return nil, err
 	}
 
-	exprs = append(exprs, leftExpr)
+	exprs := appendAndExprs(nil, expr)
 
-	// While there are tokens and the next token is AND
 	for p.currentTokenKind() == lexer.And {
 		p.advance() // Consume the AND
 
-		rightExpr, err := p.parseExpression()
+		rightExpr, err := p.parseUnaryExpression()
 		if err != nil {
 			return nil, err
 		}
 
-		exprs = append(exprs, rightExpr)
+		exprs = appendAndExprs(exprs, rightExpr)
+	}
+
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+
+	return &AndExpr{Exprs: exprs}, nil
+}
+
+//nolint:ireturn
+func (p *parser) parseOrExpression() (Expr, error) {
+	expr, err := p.parseAndExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := appendOrExprs(nil, expr)
+
+	for p.currentTokenKind() == lexer.Or {
+		p.advance() // Consume the OR
+
+		rightExpr, err := p.parseAndExpression()
+		if err != nil {
+			return nil, err
+		}
+
+		exprs = appendOrExprs(exprs, rightExpr)
+	}
+
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+
+	return &OrExpr{Exprs: exprs}, nil
+}
+
+// appendAndExprs flattens nested conjunctions, e.g. from `(a AND b) AND c`.
+func appendAndExprs(exprs []Expr, expr Expr) []Expr {
+	if andExpr, ok := expr.(*AndExpr); ok {
+		return append(exprs, andExpr.Exprs...)
+	}
+
+	return append(exprs, expr)
+}
+
+// appendOrExprs flattens nested disjunctions, e.g. from `(a OR b) OR c`.
+func appendOrExprs(exprs []Expr, expr Expr) []Expr {
+	if orExpr, ok := expr.(*OrExpr); ok {
+		return append(exprs, orExpr.Exprs...)
+	}
+
+	return append(exprs, expr)
+}
+
+// parse returns the filter as a conjunction, whose operands are comparisons unless
+// the filter uses OR, NOT or parentheses.
+func (p *parser) parse() (*AndExpr, error) {
+	expr, err := p.parseOrExpression()
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing initial expression: %w", err)
 	}
 
 	if p.hasTokens() {
-		return nil, NewParserError(
+		return nil, p.errorf(
 			"unexpected leftover token(s) after parsing: %s",
 			p.printCurrentToken(),
 		)
 	}
 
-	return &AndExpr{Exprs: exprs}, nil
+	if andExpr, ok := expr.(*AndExpr); ok {
+		return andExpr, nil
+	}
+
+	return &AndExpr{Exprs: []Expr{expr}}, nil
 }
 
 func Parse(tokens []lexer.Token) (*AndExpr, error) {
@@ -13,6 +13,24 @@ type Sample struct {
 	expected *parser.AndExpr
 }
 
+// withoutTokens clears the tokens of the comparisons of expr, which TestComparisonTokens covers.
+func withoutTokens(expr parser.Expr) {
+	switch typedExpr := expr.(type) {
+	case *parser.CompareExpr:
+		typedExpr.Tokens = parser.CompareTokens{}
+	case *parser.AndExpr:
+		for _, expr := range typedExpr.Exprs {
+			withoutTokens(expr)
+		}
+	case *parser.OrExpr:
+		for _, expr := range typedExpr.Exprs {
+			withoutTokens(expr)
+		}
+	case *parser.NotExpr:
+		withoutTokens(typedExpr.Expr)
+	}
+}
+
 //nolint:funlen
 func TestQueries(t *testing.T) {
 	t.Parallel()
@@ -21,8 +39,8 @@ func TestQueries(t *testing.T) {
 		{
 			input: "metrics.accuracy > 0.72",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"metrics", "accuracy"},
 						Operator: parser.Greater,
 						Right:    parser.NumberExpr{Value: 0.72},
@@ -33,8 +51,8 @@ func TestQueries(t *testing.T) {
 		{
 			input: "metrics.\"accuracy\" > 0.72",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"metrics", "accuracy"},
 						Operator: parser.Greater,
 						Right:    parser.NumberExpr{Value: 0.72},
@@ -45,13 +63,13 @@ func TestQueries(t *testing.T) {
 		{
 			input: "metrics.accuracy > 0.72 AND metrics.loss <= 0.15",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"metrics", "accuracy"},
 						Operator: parser.Greater,
 						Right:    parser.NumberExpr{Value: 0.72},
 					},
-					{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"metrics", "loss"},
 						Operator: parser.LessEquals,
 						Right:    parser.NumberExpr{Value: 0.15},
@@ -62,8 +80,8 @@ func TestQueries(t *testing.T) {
 		{
 			input: "params.batch_size = \"2\"",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"params", "batch_size"},
 						Operator: parser.Equals,
 						Right:    parser.StringExpr{Value: "2"},
@@ -74,8 +92,8 @@ func TestQueries(t *testing.T) {
 		{
 			input: "tags.task ILIKE \"classif%\"",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"tags", "task"},
 						Operator: parser.ILike,
 						Right:    parser.StringExpr{Value: "classif%"},
@@ -86,8 +104,8 @@ func TestQueries(t *testing.T) {
 		{
 			input: "datasets.digest IN ('s8ds293b', 'jks834s2')",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"datasets", "digest"},
 						Operator: parser.In,
 						Right:    parser.StringListExpr{Values: []string{"s8ds293b", "jks834s2"}},
@@ -95,11 +113,91 @@ func TestQueries(t *testing.T) {
 				},
 			},
 		},
+		{
+			input: "metrics.acc > 0.9 OR tags.stage = 'prod' AND params.lr = '0.01'",
+			expected: &parser.AndExpr{
+				Exprs: []parser.Expr{
+					&parser.OrExpr{
+						Exprs: []parser.Expr{
+							&parser.CompareExpr{
+								Left:     parser.Identifier{"metrics", "acc"},
+								Operator: parser.Greater,
+								Right:    parser.NumberExpr{Value: 0.9},
+							},
+							&parser.AndExpr{
+								Exprs: []parser.Expr{
+									&parser.CompareExpr{
+										Left:     parser.Identifier{"tags", "stage"},
+										Operator: parser.Equals,
+										Right:    parser.StringExpr{Value: "prod"},
+									},
+									&parser.CompareExpr{
+										Left:     parser.Identifier{"params", "lr"},
+										Operator: parser.Equals,
+										Right:    parser.StringExpr{Value: "0.01"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			input: "(metrics.acc > 0.9 or tags.stage = 'prod') AND NOT (params.lr = '0.01')",
+			expected: &parser.AndExpr{
+				Exprs: []parser.Expr{
+					&parser.OrExpr{
+						Exprs: []parser.Expr{
+							&parser.CompareExpr{
+								Left:     parser.Identifier{"metrics", "acc"},
+								Operator: parser.Greater,
+								Right:    parser.NumberExpr{Value: 0.9},
+							},
+							&parser.CompareExpr{
+								Left:     parser.Identifier{"tags", "stage"},
+								Operator: parser.Equals,
+								Right:    parser.StringExpr{Value: "prod"},
+							},
+						},
+					},
+					&parser.NotExpr{
+						Expr: &parser.CompareExpr{
+							Left:     parser.Identifier{"params", "lr"},
+							Operator: parser.Equals,
+							Right:    parser.StringExpr{Value: "0.01"},
+						},
+					},
+				},
+			},
+		},
+		{
+			input: "(params.a = 'x' AND params.b = 'y') AND params.c NOT IN ('z')",
+			expected: &parser.AndExpr{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
+						Left:     parser.Identifier{"params", "a"},
+						Operator: parser.Equals,
+						Right:    parser.StringExpr{Value: "x"},
+					},
+					&parser.CompareExpr{
+						Left:     parser.Identifier{"params", "b"},
+						Operator: parser.Equals,
+						Right:    parser.StringExpr{Value: "y"},
+					},
+					&parser.CompareExpr{
+						Left:     parser.Identifier{"params", "c"},
+						Operator: parser.NotIn,
+						Right:    parser.StringListExpr{Values: []string{"z"}},
+					},
+				},
+			},
+		},
 		{
 			input: "attributes.created > 1664067852747",
 			expected: &parser.AndExpr{
-				[]*parser.CompareExpr{
-					{
+				[]parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"attributes", "created"},
 						Operator: parser.Greater,
 						Right:    parser.NumberExpr{Value: 1664067852747},
@@ -110,8 +208,8 @@ func TestQueries(t *testing.T) {
 		{
 			input: "params.batch_size != \"None\"",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"params", "batch_size"},
 						Operator: parser.NotEquals,
 						Right:    parser.StringExpr{Value: "None"},
@@ -122,8 +220,8 @@ func TestQueries(t *testing.T) {
 		{
 			input: "datasets.digest NOT IN ('s8ds293b', 'jks834s2')",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"datasets", "digest"},
 						Operator: parser.NotIn,
 						Right:    parser.StringListExpr{Values: []string{"s8ds293b", "jks834s2"}},
@@ -131,6 +229,23 @@ func TestQueries(t *testing.T) {
 				},
 			},
 		},
+		{
+			input: "metrics.accuracy IS NULL AND tags.stage is not null",
+			expected: &parser.AndExpr{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
+						Left:     parser.Identifier{"metrics", "accuracy"},
+						Operator: parser.IsNull,
+						Right:    parser.NullExpr{},
+					},
+					&parser.CompareExpr{
+						Left:     parser.Identifier{"tags", "stage"},
+						Operator: parser.IsNotNull,
+						Right:    parser.NullExpr{},
+					},
+				},
+			},
+		},
 	}
 
 	for _, sample := range samples {
@@ -149,6 +264,8 @@ func TestQueries(t *testing.T) {
 				t.Errorf("error parsing: %s", err)
 			}
 
+			withoutTokens(ast)
+
 			if !reflect.DeepEqual(ast, currentSample.expected) {
 				t.Errorf("expected %#v, got %#v", currentSample.expected, ast)
 			}
@@ -161,6 +278,10 @@ func TestInvalidSyntax(t *testing.T) {
 
 	samples := []string{
 		"attribute.status IS 'RUNNING'",
+		"(metrics.acc > 0.9 OR tags.stage = 'prod'",
+		"metrics.acc > 0.9 OR",
+		"NOT",
+		"metrics.acc > 0.9 tags.stage = 'prod'",
 	}
 
 	for _, sample := range samples {
@@ -180,3 +301,41 @@ func TestInvalidSyntax(t *testing.T) {
 		})
 	}
 }
+
+func TestComparisonTokens(t *testing.T) {
+	t.Parallel()
+
+	input := "run_name = 'a' AND datasets.digest NOT IN ('b')"
+
+	tokens, err := lexer.Tokenize(&input)
+	if err != nil {
+		t.Fatalf("unexpected lex error: %v", err)
+	}
+
+	ast, err := parser.Parse(tokens)
+	if err != nil {
+		t.Fatalf("error parsing: %s", err)
+	}
+
+	expected := []parser.CompareTokens{
+		{
+			Identifier: lexer.Token{Kind: lexer.Identifier, Value: "run_name", Offset: 0},
+			Key:        lexer.Token{Kind: lexer.Identifier, Value: "run_name", Offset: 0},
+			Operator:   lexer.Token{Kind: lexer.Equals, Value: "=", Offset: 9},
+			Value:      lexer.Token{Kind: lexer.String, Value: "'a'", Offset: 11},
+		},
+		{
+			Identifier: lexer.Token{Kind: lexer.Identifier, Value: "datasets", Offset: 19},
+			Key:        lexer.Token{Kind: lexer.Identifier, Value: "digest", Offset: 28},
+			Operator:   lexer.Token{Kind: lexer.Not, Value: "NOT", Offset: 35},
+			Value:      lexer.Token{Kind: lexer.OpenParen, Value: "(", Offset: 42},
+		},
+	}
+
+	for index, expr := range ast.Exprs {
+		compareExpr, ok := expr.(*parser.CompareExpr)
+		if !ok || !reflect.DeepEqual(compareExpr.Tokens, expected[index]) {
+			t.Errorf("expected %#v, got %#v", expected[index], expr)
+		}
+	}
+}
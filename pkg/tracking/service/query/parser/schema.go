@@ -0,0 +1,231 @@
+package parser
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+/*
+
+A Schema describes the filter of a searchable entity: its identifiers, their keys and the
+comparisons each key supports. Every search validates its filter against its own schema,
+so that the grammar, and the error messages, are the same for all of them.
+
+Identifiers either have a fixed set of keys, like the attributes, or accept any key, like
+the metrics. A key without identifier has the "" identifier, usually an alias of "attribute".
+
+*/
+
+// ValueKind is the type of the values a key is compared to.
+type ValueKind int
+
+const (
+	StringValue ValueKind = iota
+	NumberValue
+	IntegerValue
+)
+
+func (k ValueKind) String() string {
+	switch k {
+	case NumberValue:
+		return "a numeric value"
+	case IntegerValue:
+		return "an integer value"
+	case StringValue:
+		return "a quoted string value"
+	default:
+		return "unknown"
+	}
+}
+
+// Field is what a key can be compared with: the supported operators and the type of the values.
+// Lists of values are only accepted by IN and NOT IN, and only for strings.
+type Field struct {
+	Operators []OperatorKind
+	Value     ValueKind
+}
+
+// Key is a key of an identifier with a fixed set of keys.
+type Key struct {
+	Name string
+	// Aliases are other names of the key, which are not listed in error messages.
+	Aliases []string
+	// Column is the name the key is resolved to, Name when empty.
+	Column string
+	Field
+}
+
+// Schema describes the filter of a searchable entity.
+type Schema struct {
+	// Identifiers maps the identifiers, and their aliases, to what they refer to.
+	Identifiers map[string]ValidIdentifier
+	// Keys are the keys of the identifiers with a fixed set of keys.
+	Keys map[ValidIdentifier][]Key
+	// Fields are the comparisons supported by any key of the other identifiers.
+	Fields map[ValidIdentifier]Field
+	// legacyError returns the message the entity had for an invalid comparison before it had
+	// a schema, if any, for the clients matching on it.
+	legacyError func(identifier ValidIdentifier, key string, expression *CompareExpr) *ValidationError
+}
+
+var (
+	numericOperators = []OperatorKind{Equals, NotEquals, Less, LessEquals, Greater, GreaterEquals}
+	stringOperators  = []OperatorKind{Equals, NotEquals, Like, ILike}
+	listOperators    = []OperatorKind{In, NotIn}
+	nullOperators    = []OperatorKind{IsNull, IsNotNull}
+)
+
+var (
+	numericField    = Field{Operators: numericOperators, Value: NumberValue}
+	integerField    = Field{Operators: numericOperators, Value: IntegerValue}
+	stringField     = Field{Operators: stringOperators, Value: StringValue}
+	stringListField = Field{Operators: slices.Concat(stringOperators, listOperators), Value: StringValue}
+)
+
+// operatorName is the name of op in a filter, which for null checks includes NULL.
+func operatorName(op OperatorKind) string {
+	if op.IsNullCheck() {
+		return op.String() + " NULL"
+	}
+
+	return op.String()
+}
+
+// operatorNames lists ops like "'=', '!=' and 'LIKE'".
+func operatorNames(ops []OperatorKind) string {
+	names := make([]string, 0, len(ops))
+	for _, op := range ops {
+		names = append(names, fmt.Sprintf("'%s'", operatorName(op)))
+	}
+
+	if len(names) < 2 { //nolint:mnd
+		return strings.Join(names, "")
+	}
+
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
+
+func (s *Schema) identifier(name string) (ValidIdentifier, error) {
+	identifier, ok := s.Identifiers[name]
+	if ok {
+		return identifier, nil
+	}
+
+	// sorted, so that the suggestion doesn't depend on the iteration order of the map.
+	names := make([]string, 0, len(s.Identifiers))
+
+	for candidate := range s.Identifiers {
+		if candidate != "" {
+			names = append(names, candidate)
+		}
+	}
+
+	slices.Sort(names)
+
+	return -1, newInvalidIdentifierError(name, names)
+}
+
+// Key resolves key of identifier to its column, and returns the comparisons it supports.
+func (s *Schema) Key(identifier ValidIdentifier, key string) (string, Field, error) {
+	keys, isFixed := s.Keys[identifier]
+	if !isFixed {
+		field, ok := s.Fields[identifier]
+		if !ok {
+			return "", Field{}, newValidationErrorOn(identifierPart, "%s is not supported in this filter", identifier)
+		}
+
+		return key, field, nil
+	}
+
+	names := make([]string, 0, len(keys))
+
+	for _, candidate := range keys {
+		if candidate.Name == key || slices.Contains(candidate.Aliases, key) {
+			if candidate.Column == "" {
+				return candidate.Name, candidate.Field, nil
+			}
+
+			return candidate.Column, candidate.Field, nil
+		}
+
+		names = append(names, candidate.Name)
+	}
+
+	return "", Field{}, newInvalidKeyError(identifier.String(), key, names)
+}
+
+// value checks the type of value, key being the name of the key in the filter.
+func (f Field) value(identifier ValidIdentifier, key string, value Value) (interface{}, error) {
+	switch typedValue := value.(type) {
+	case StringExpr, StringListExpr:
+		if f.Value == StringValue {
+			return value.value(), nil
+		}
+	case NumberExpr:
+		switch f.Value {
+		case NumberValue:
+			return typedValue.Value, nil
+		case IntegerValue:
+			if typedValue.Value == math.Trunc(typedValue.Value) {
+				return int64(typedValue.Value), nil
+			}
+		case StringValue:
+		}
+	}
+
+	return nil, NewValidationError("expected %s for %s: %s. Found %s", f.Value, identifier, key, value)
+}
+
+// invalid returns the error of the invalid expression, err unless the schema has a legacy one.
+func (s *Schema) invalid(identifier ValidIdentifier, key string, expression *CompareExpr, err error) error {
+	if s.legacyError != nil {
+		if legacyErr := s.legacyError(identifier, key, expression); legacyErr != nil {
+			err = legacyErr
+		}
+	}
+
+	return fmt.Errorf("Error on parsing filter expression: %w", err)
+}
+
+// Validate type-checks expression according to the schema, resolving the aliases of its
+// identifier and key. Not every parsed comparison is a valid one.
+func (s *Schema) Validate(expression *CompareExpr) (*ValidCompareExpr, error) {
+	identifier, err := s.identifier(expression.Left.Identifier)
+	if err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	validKey, field, err := s.Key(identifier, expression.Left.Key)
+	if err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	// errors refer to the key of the filter rather than to the column it is resolved to.
+	key := expression.Left.Key
+
+	if !slices.Contains(field.Operators, expression.Operator) {
+		return nil, s.invalid(identifier, validKey, expression, newValidationErrorOn(
+			operatorPart,
+			"invalid comparator %s for %s: %s. Only %s are supported",
+			operatorName(expression.Operator), identifier, key, operatorNames(field.Operators),
+		))
+	}
+
+	var value interface{}
+
+	if !expression.Operator.IsNullCheck() {
+		value, err = field.value(identifier, key, expression.Right)
+		if err != nil {
+			return nil, s.invalid(identifier, validKey, expression, err)
+		}
+	}
+
+	return &ValidCompareExpr{
+		Identifier: identifier,
+		Key:        validKey,
+		Operator:   expression.Operator,
+		Value:      value,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package parser
+
+import "strings"
+
+// closestName returns the name closest to name, if name is likely a misspelling of it.
+func closestName(name string, names []string) string {
+	closest := ""
+	closestDistance := 0
+
+	for _, candidate := range names {
+		distance := editDistance(strings.ToLower(name), strings.ToLower(candidate))
+
+		// allow about one typo per three characters of the candidate.
+		if distance*3 > len(candidate) {
+			continue
+		}
+
+		if closest == "" || distance < closestDistance {
+			closest = candidate
+			closestDistance = distance
+		}
+	}
+
+	return closest
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	source, target := []rune(a), []rune(b)
+
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := range source {
+		current[0] = i + 1
+
+		for j := range target {
+			cost := 1
+			if source[i] == target[j] {
+				cost = 0
+			}
+
+			current[j+1] = min(previous[j+1]+1, current[j]+1, previous[j]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(target)]
+}
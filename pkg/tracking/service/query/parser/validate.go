@@ -3,15 +3,14 @@ package parser
 import (
 	"errors"
 	"fmt"
-	"strings"
+	"slices"
 
-	"github.com/mlflow/mlflow-go-backend/pkg/contract"
-	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/lexer"
 )
 
 /*
 
-This is the equivalent of type-checking the untyped tree.
+Validation is the equivalent of type-checking the untyped tree, see Schema.
 Not every parsed tree is a valid one.
 
 Grammar rule: identifier.key operator value
@@ -38,6 +37,7 @@ const (
 	Tag
 	Attribute
 	Dataset
+	RequestMetadata
 )
 
 func (v ValidIdentifier) String() string {
@@ -52,6 +52,8 @@ func (v ValidIdentifier) String() string {
 		return "attribute"
 	case Dataset:
 		return "dataset"
+	case RequestMetadata:
+		return "request_metadata"
 	default:
 		return "unknown"
 	}
@@ -68,145 +70,111 @@ func (v ValidCompareExpr) String() string {
 	return fmt.Sprintf("%s.%s %s %v", v.Identifier, v.Key, v.Operator, v.Value)
 }
 
+func (v *ValidCompareExpr) expr() {}
+
+// comparePart is the part of a comparison a ValidationError is about.
+type comparePart int
+
+const (
+	valuePart comparePart = iota
+	identifierPart
+	keyPart
+	operatorPart
+)
+
 type ValidationError struct {
 	message string
+	part    comparePart
+	token   *lexer.Token
+	// Suggestion is the valid name closest to a misspelled identifier or key.
+	Suggestion string
+	Location   *lexer.Location
 }
 
 func (e *ValidationError) Error() string {
+	if e.Suggestion != "" {
+		return fmt.Sprintf("%s. Did you mean '%s'?", e.message, e.Suggestion)
+	}
+
 	return e.message
 }
 
+// NewValidationError returns an error about the value of a comparison.
 func NewValidationError(format string, a ...interface{}) *ValidationError {
-	return &ValidationError{message: fmt.Sprintf(format, a...)}
+	return newValidationErrorOn(valuePart, format, a...)
 }
 
-const (
-	metricIdentifier    = "metric"
-	parameterIdentifier = "parameter"
-	tagIdentifier       = "tag"
-	attributeIdentifier = "attribute"
-	datasetIdentifier   = "dataset"
-)
-
-var identifiers = []string{
-	metricIdentifier,
-	parameterIdentifier,
-	tagIdentifier,
-	attributeIdentifier,
-	datasetIdentifier,
+func newValidationErrorOn(part comparePart, format string, a ...interface{}) *ValidationError {
+	return &ValidationError{message: fmt.Sprintf(format, a...), part: part}
 }
 
-func parseValidIdentifier(identifier string) (ValidIdentifier, error) {
-	switch identifier {
-	case metricIdentifier, "metrics":
-		return Metric, nil
-	case parameterIdentifier, "parameters", "param", "params":
-		return Parameter, nil
-	case tagIdentifier, "tags":
-		return Tag, nil
-	case "", attributeIdentifier, "attr", "attributes", "run":
-		return Attribute, nil
-	case datasetIdentifier, "datasets":
-		return Dataset, nil
-	default:
-		return -1, NewValidationError("invalid identifier %q", identifier)
-	}
+func newInvalidIdentifierError(identifier string, validIdentifiers []string) *ValidationError {
+	err := newValidationErrorOn(identifierPart, "invalid identifier %q", identifier)
+	err.Suggestion = closestName(identifier, validIdentifiers)
+
+	return err
 }
 
-const (
-	RunID     = "run_id"
-	RunName   = "run_name"
-	Created   = "created"
-	StartTime = "start_time"
-)
+func newInvalidKeyError(kind, key string, validKeys []string) *ValidationError {
+	err := newValidationErrorOn(keyPart, "Invalid %s key '{%s}' specified. Valid keys are '%v'", kind, key, validKeys)
+	err.Suggestion = closestName(key, validKeys)
 
-// This should be configurable and only applies to the runs table.
-var searchableRunAttributes = []string{
-	RunID,
-	RunName,
-	"user_id",
-	"status",
-	StartTime,
-	"end_time",
-	"artifact_uri",
+	return err
 }
 
-var datasetAttributes = []string{"name", "digest", "context"}
-
-func parseAttributeKey(key string) (string, error) {
-	switch key {
-	case "run_id":
-		// We return run_uuid before that is the SQL column name.
-		return "run_uuid", nil
-	case
-		"user_id",
-		"status",
-		StartTime,
-		"end_time",
-		"artifact_uri":
-		return key, nil
-	case Created, "Created":
-		return StartTime, nil
-	case RunName, "run name", "Run name", "Run Name":
-		return RunName, nil
-	default:
-		return "", contract.NewError(protos.ErrorCode_BAD_REQUEST,
-			fmt.Sprintf(
-				"Invalid attribute key '{%s}' specified. Valid keys are '%v'",
-				key,
-				searchableRunAttributes,
-			),
-		)
+// WithTokens points the ValidationError of err, returned when validating expression, at the
+// token of expression it is about.
+func WithTokens(err error, expression *CompareExpr) error {
+	var validationError *ValidationError
+	if !errors.As(err, &validationError) || validationError.token != nil || expression.Tokens == (CompareTokens{}) {
+		return err
 	}
-}
 
-func parseKey(identifier ValidIdentifier, key string) (string, error) {
-	if key == "" {
-		return attributeIdentifier, nil
-	}
+	token := expression.Tokens.Value
 
-	//nolint:exhaustive
-	switch identifier {
-	case Attribute:
-		return parseAttributeKey(key)
-	case Dataset:
-		switch key {
-		case "name", "digest", "context":
-			return key, nil
-		default:
-			return "", contract.NewError(protos.ErrorCode_BAD_REQUEST,
-				fmt.Sprintf(
-					"Invalid dataset key '{%s}' specified. Valid keys are '%v'",
-					key,
-					searchableRunAttributes,
-				),
-			)
-		}
-	default:
-		return key, nil
+	switch validationError.part {
+	case identifierPart:
+		token = expression.Tokens.Identifier
+	case keyPart:
+		token = expression.Tokens.Key
+	case operatorPart:
+		token = expression.Tokens.Operator
+	case valuePart:
 	}
+
+	validationError.token = &token
+
+	return err
 }
 
-// Returns a standardized LongIdentifierExpr.
-func validatedIdentifier(identifier *Identifier) (ValidIdentifier, string, error) {
-	validIdentifier, err := parseValidIdentifier(identifier.Identifier)
-	if err != nil {
-		return -1, "", err
+// Locate sets and returns the Location of the error in source, the filter which was validated.
+// It is not part of the message, as the error is wrapped by then.
+func (e *ValidationError) Locate(source string) *lexer.Location {
+	if e.token != nil {
+		e.Location = tokenLocation(source, *e.token)
 	}
 
-	validKey, err := parseKey(validIdentifier, identifier.Key)
-	if err != nil {
-		return -1, "", err
-	}
+	return e.Location
+}
 
-	identifier.Key = validKey
+const (
+	metricIdentifier    = "metric"
+	parameterIdentifier = "parameter"
+	tagIdentifier       = "tag"
+	attributeIdentifier = "attribute"
+	datasetIdentifier   = "dataset"
+)
 
-	return validIdentifier, validKey, nil
-}
+const (
+	RunID     = "run_id"
+	RunName   = "run_name"
+	Created   = "created"
+	StartTime = "start_time"
+)
 
 /*
 
-The value part is determined by the identifier
+The value part is determined by the key
 
 "metric" takes numbers
 "parameter" and "tag" takes strings
@@ -217,113 +185,106 @@ otherwise string
 
 "dataset" takes strings for "name", "digest" and "context"
 
+IS NULL and IS NOT NULL take no value and only apply to "metric", "parameter" and "tag".
+
 */
 
-func validateDatasetValue(key string, value Value) (interface{}, error) {
-	switch key {
-	case "name", "digest", "context":
-		if _, ok := value.(NumberExpr); ok {
-			return nil, NewValidationError(
-				"expected datasets.%s to be either a string or list of strings. Found %s",
-				key,
-				value,
-			)
+// RunSchema is the schema of the filter of the runs. Port of the validation in search_utils.py.
+var RunSchema = &Schema{
+	Identifiers: map[string]ValidIdentifier{
+		metricIdentifier:    Metric,
+		"metrics":           Metric,
+		parameterIdentifier: Parameter,
+		"parameters":        Parameter,
+		"param":             Parameter,
+		"params":            Parameter,
+		tagIdentifier:       Tag,
+		"tags":              Tag,
+		"":                  Attribute,
+		attributeIdentifier: Attribute,
+		"attr":              Attribute,
+		"attributes":        Attribute,
+		"run":               Attribute,
+		datasetIdentifier:   Dataset,
+		"datasets":          Dataset,
+	},
+	Keys: map[ValidIdentifier][]Key{
+		// This should be configurable and only applies to the runs table.
+		Attribute: {
+			// run_uuid is the SQL column name.
+			{Name: RunID, Column: "run_uuid", Field: stringListField},
+			{Name: RunName, Aliases: []string{"run name", "Run name", "Run Name"}, Field: stringField},
+			{Name: "user_id", Field: stringField},
+			{Name: "status", Field: stringField},
+			{Name: StartTime, Aliases: []string{Created, "Created"}, Field: numericField},
+			{Name: "end_time", Field: numericField},
+			{Name: "artifact_uri", Field: stringField},
+		},
+		Dataset: {
+			{Name: "name", Field: stringListField},
+			{Name: "digest", Field: stringListField},
+			{Name: "context", Field: stringListField},
+		},
+	},
+	Fields: map[ValidIdentifier]Field{
+		Metric:    {Operators: slices.Concat(numericOperators, nullOperators), Value: NumberValue},
+		Parameter: {Operators: slices.Concat(stringOperators, nullOperators), Value: StringValue},
+		Tag:       {Operators: slices.Concat(stringOperators, nullOperators), Value: StringValue},
+	},
+	legacyError: runsLegacyError,
+}
+
+// runsLegacyError returns the message search_utils.py gives for an invalid comparison of the
+// runs filter, key being resolved, or nil when the schema's own message applies.
+func runsLegacyError(identifier ValidIdentifier, key string, expression *CompareExpr) *ValidationError {
+	if expression.Operator.IsNullCheck() {
+		if identifier == Metric || identifier == Parameter || identifier == Tag {
+			return nil
 		}
 
-		return value.value(), nil
-	default:
-		return nil, NewValidationError(
-			"expected dataset attribute key to be one of %s. Found %s",
-			strings.Join(datasetAttributes, ", "),
+		return newValidationErrorOn(
+			operatorPart,
+			"IS NULL and IS NOT NULL are only supported for metrics, parameters and tags. Found %s: %s",
+			identifier,
 			key,
 		)
 	}
-}
 
-// Port of _get_value in search_utils.py.
-func validateValue(identifier ValidIdentifier, key string, value Value) (interface{}, error) {
+	value := expression.Right
+
+	//nolint:exhaustive
 	switch identifier {
 	case Metric:
 		if _, ok := value.(NumberExpr); !ok {
-			return nil, NewValidationError(
-				"expected numeric value type for metric. Found %s",
-				value,
-			)
+			return NewValidationError("expected numeric value type for metric. Found %s", value)
 		}
-
-		return value.value(), nil
 	case Parameter, Tag:
 		if _, ok := value.(StringExpr); !ok {
-			return nil, NewValidationError(
-				"expected a quoted string value for %s. Found %s",
-				identifier, value,
-			)
+			return NewValidationError("expected a quoted string value for %s. Found %s", identifier, value)
 		}
-
-		return value.value(), nil
 	case Attribute:
-		value, err := validateAttributeValue(key, value)
-
-		return value, err
+		if key == StartTime || key == "end_time" {
+			if _, ok := value.(NumberExpr); !ok {
+				return NewValidationError(
+					"expected numeric value type for numeric attribute: %s. Found %s",
+					key,
+					value,
+				)
+			}
+		} else if _, ok := value.(StringListExpr); ok && key != "run_uuid" {
+			return NewValidationError(
+				"only the 'run_id' attribute supports comparison with a list of quoted string values",
+			)
+		}
 	case Dataset:
-		return validateDatasetValue(key, value)
-	default:
-		return nil, NewValidationError(
-			"Invalid identifier type %s. Expected one of %s",
-			identifier,
-			strings.Join(identifiers, ", "),
-		)
-	}
-}
-
-func validateAttributeValue(key string, value Value) (interface{}, error) {
-	switch key {
-	case StartTime, "end_time", Created:
-		if _, ok := value.(NumberExpr); !ok {
-			return nil, NewValidationError(
-				"expected numeric value type for numeric attribute: %s. Found %s",
+		if _, ok := value.(NumberExpr); ok {
+			return NewValidationError(
+				"expected datasets.%s to be either a string or list of strings. Found %s",
 				key,
 				value,
 			)
 		}
-
-		return value.value(), nil
-	default:
-		// run_id was earlier converted to run_uuid
-		if _, ok := value.(StringListExpr); key != "run_uuid" && ok {
-			return nil, NewValidationError(
-				"only the 'run_id' attribute supports comparison with a list of quoted string values",
-			)
-		}
-
-		return value.value(), nil
-	}
-}
-
-// Validate an expression according to the mlflow domain.
-// This represent is a simple type-checker for the expression.
-// Not every identifier is valid according to the mlflow domain.
-// The same for the value part.
-func ValidateExpression(expression *CompareExpr) (*ValidCompareExpr, error) {
-	validIdentifier, validKey, err := validatedIdentifier(&expression.Left)
-	if err != nil {
-		var contractError *contract.Error
-		if errors.As(err, &contractError) {
-			return nil, contractError
-		}
-
-		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
-	}
-
-	value, err := validateValue(validIdentifier, validKey, expression.Right)
-	if err != nil {
-		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
 	}
 
-	return &ValidCompareExpr{
-		Identifier: validIdentifier,
-		Key:        validKey,
-		Operator:   expression.Operator,
-		Value:      value,
-	}, nil
+	return nil
 }
@@ -0,0 +1,32 @@
+package parser
+
+/*
+
+Experiments support a subset of the grammar of runs:
+
+tag.key       -> experiment_tags
+attribute.key -> experiments columns
+
+*/
+
+// ExperimentSchema is the schema of the filter of the experiments.
+var ExperimentSchema = &Schema{
+	Identifiers: map[string]ValidIdentifier{
+		tagIdentifier:       Tag,
+		"tags":              Tag,
+		"":                  Attribute,
+		attributeIdentifier: Attribute,
+		"attr":              Attribute,
+		"attributes":        Attribute,
+	},
+	Keys: map[ValidIdentifier][]Key{
+		Attribute: {
+			{Name: "name", Field: stringField},
+			{Name: "creation_time", Field: integerField},
+			{Name: "last_update_time", Field: integerField},
+		},
+	},
+	Fields: map[ValidIdentifier]Field{
+		Tag: stringField,
+	},
+}
@@ -0,0 +1,75 @@
+package parser
+
+/*
+
+Logged models share the grammar of runs, but have their own identifiers:
+
+metric.key    -> logged_model_metrics
+param.key     -> logged_model_params
+tag.key       -> logged_model_tags
+attribute.key -> logged_models columns
+
+*/
+
+const (
+	LoggedModelName                   = "name"
+	LoggedModelID                     = "model_id"
+	LoggedModelType                   = "model_type"
+	LoggedModelStatus                 = "status"
+	LoggedModelSourceRunID            = "source_run_id"
+	LoggedModelCreationTimestampMS    = "creation_timestamp_ms"
+	LoggedModelLastUpdatedTimestampMS = "last_updated_timestamp_ms"
+)
+
+// LoggedModelSchema is the schema of the filter of the logged models.
+var LoggedModelSchema = &Schema{
+	Identifiers: map[string]ValidIdentifier{
+		metricIdentifier:    Metric,
+		"metrics":           Metric,
+		parameterIdentifier: Parameter,
+		"parameters":        Parameter,
+		"param":             Parameter,
+		"params":            Parameter,
+		tagIdentifier:       Tag,
+		"tags":              Tag,
+		"":                  Attribute,
+		attributeIdentifier: Attribute,
+		"attr":              Attribute,
+		"attributes":        Attribute,
+	},
+	Keys: map[ValidIdentifier][]Key{
+		Attribute: {
+			{Name: LoggedModelName, Field: stringListField},
+			{Name: LoggedModelID, Field: stringListField},
+			{Name: LoggedModelType, Field: stringListField},
+			{Name: LoggedModelStatus, Field: stringListField},
+			{Name: LoggedModelSourceRunID, Field: stringListField},
+			{
+				Name:    "creation_timestamp",
+				Aliases: []string{LoggedModelCreationTimestampMS},
+				Column:  LoggedModelCreationTimestampMS,
+				Field:   numericField,
+			},
+			{Name: "creation_time", Column: LoggedModelCreationTimestampMS, Field: numericField},
+			{
+				Name:    "last_updated_timestamp",
+				Aliases: []string{LoggedModelLastUpdatedTimestampMS},
+				Column:  LoggedModelLastUpdatedTimestampMS,
+				Field:   numericField,
+			},
+			{Name: "last_updated_time", Column: LoggedModelLastUpdatedTimestampMS, Field: numericField},
+		},
+	},
+	Fields: map[ValidIdentifier]Field{
+		Metric:    numericField,
+		Parameter: stringField,
+		Tag:       stringField,
+	},
+}
+
+// ParseLoggedModelAttributeKey resolves the aliases of a logged model attribute to its column name.
+func ParseLoggedModelAttributeKey(key string) (string, error) {
+	column, _, err := LoggedModelSchema.Key(Attribute, key)
+
+	return column, err
+}
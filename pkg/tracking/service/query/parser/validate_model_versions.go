@@ -0,0 +1,40 @@
+package parser
+
+/*
+
+Model versions support a subset of the grammar of runs:
+
+tag.key       -> model_version_tags
+attribute.key -> model_versions columns
+
+The attributes are resolved to their columns: version_number -> version, source_path -> source.
+
+*/
+
+const (
+	ModelVersionName         = "name"
+	ModelVersionVersion      = "version"
+	ModelVersionRunID        = "run_id"
+	ModelVersionSource       = "source"
+	ModelVersionCurrentStage = "current_stage"
+)
+
+// ModelVersionSchema is the schema of the filter of the model versions.
+var ModelVersionSchema = &Schema{
+	Identifiers: registryIdentifiers,
+	Keys: map[ValidIdentifier][]Key{
+		Attribute: {
+			{Name: ModelVersionName, Field: stringField},
+			{Name: "version_number", Column: ModelVersionVersion, Field: integerField},
+			{Name: ModelVersionRunID, Field: Field{Operators: equalityListOperators, Value: StringValue}},
+			{Name: "source_path", Column: ModelVersionSource, Field: stringField},
+			{Name: ModelVersionCurrentStage, Field: Field{Operators: equalityListOperators, Value: StringValue}},
+		},
+	},
+	Fields: map[ValidIdentifier]Field{
+		Tag: stringField,
+	},
+}
+
+// equalityListOperators are the operators of the attributes which are neither patterns nor ordered.
+var equalityListOperators = []OperatorKind{Equals, NotEquals, In, NotIn}
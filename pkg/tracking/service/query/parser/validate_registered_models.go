@@ -0,0 +1,37 @@
+package parser
+
+/*
+
+Registered models support a subset of the grammar of runs:
+
+tag.key       -> registered_model_tags
+attribute.key -> registered_models columns
+
+Only string comparisons, i.e. =, !=, LIKE and ILIKE, are supported.
+
+*/
+
+const RegisteredModelName = "name"
+
+// registryIdentifiers are the identifiers of the searches of the model registry.
+var registryIdentifiers = map[string]ValidIdentifier{
+	tagIdentifier:       Tag,
+	"tags":              Tag,
+	"":                  Attribute,
+	attributeIdentifier: Attribute,
+	"attr":              Attribute,
+	"attributes":        Attribute,
+}
+
+// RegisteredModelSchema is the schema of the filter of the registered models.
+var RegisteredModelSchema = &Schema{
+	Identifiers: registryIdentifiers,
+	Keys: map[ValidIdentifier][]Key{
+		Attribute: {
+			{Name: RegisteredModelName, Field: stringField},
+		},
+	},
+	Fields: map[ValidIdentifier]Field{
+		Tag: stringField,
+	},
+}
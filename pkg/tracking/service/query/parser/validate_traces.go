@@ -0,0 +1,53 @@
+package parser
+
+/*
+
+Traces share the grammar of runs, but have their own identifiers:
+
+tag.key              -> trace_tags
+request_metadata.key -> trace_request_metadata
+attribute.key        -> trace_info columns
+
+The "trace" prefix is an alias for "attribute", "metadata" for "request_metadata".
+
+*/
+
+const (
+	TraceTimestampMS     = "timestamp_ms"
+	TraceExecutionTimeMS = "execution_time_ms"
+	TraceStatus          = "status"
+	TraceRequestID       = "request_id"
+	TraceName            = "name"
+	TraceRunID           = "run_id"
+)
+
+// TraceSchema is the schema of the filter of the traces.
+var TraceSchema = &Schema{
+	Identifiers: map[string]ValidIdentifier{
+		tagIdentifier:       Tag,
+		"tags":              Tag,
+		"request_metadata":  RequestMetadata,
+		"metadata":          RequestMetadata,
+		"":                  Attribute,
+		attributeIdentifier: Attribute,
+		"attr":              Attribute,
+		"attributes":        Attribute,
+		"trace":             Attribute,
+	},
+	Keys: map[ValidIdentifier][]Key{
+		Attribute: {
+			{Name: TraceRequestID, Field: stringListField},
+			{Name: "timestamp", Column: TraceTimestampMS, Field: numericField},
+			{Name: TraceTimestampMS, Field: numericField},
+			{Name: "execution_time", Column: TraceExecutionTimeMS, Field: numericField},
+			{Name: TraceExecutionTimeMS, Field: numericField},
+			{Name: TraceStatus, Field: stringField},
+			{Name: TraceName, Field: stringField},
+			{Name: TraceRunID, Field: stringField},
+		},
+	},
+	Fields: map[ValidIdentifier]Field{
+		Tag:             stringField,
+		RequestMetadata: stringField,
+	},
+}
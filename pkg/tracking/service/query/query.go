@@ -1,37 +1,113 @@
 package query
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/lexer"
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
 )
 
-func ParseFilter(input string) ([]*parser.ValidCompareExpr, error) {
+// validateExpr replaces the comparisons of expr by their validated counterparts.
+//
+//nolint:ireturn
+func validateExpr(expr parser.Expr, schema *parser.Schema) (parser.Expr, error) {
+	switch typedExpr := expr.(type) {
+	case *parser.CompareExpr:
+		validExpr, err := schema.Validate(typedExpr)
+		if err != nil {
+			return nil, parser.WithTokens(err, typedExpr)
+		}
+
+		return validExpr, nil
+	case *parser.AndExpr:
+		exprs, err := validateExprs(typedExpr.Exprs, schema)
+		if err != nil {
+			return nil, err
+		}
+
+		return &parser.AndExpr{Exprs: exprs}, nil
+	case *parser.OrExpr:
+		exprs, err := validateExprs(typedExpr.Exprs, schema)
+		if err != nil {
+			return nil, err
+		}
+
+		return &parser.OrExpr{Exprs: exprs}, nil
+	case *parser.NotExpr:
+		validExpr, err := validateExpr(typedExpr.Expr, schema)
+		if err != nil {
+			return nil, err
+		}
+
+		return &parser.NotExpr{Expr: validExpr}, nil
+	default:
+		return nil, parser.NewValidationError("unexpected expression %s", expr)
+	}
+}
+
+func validateExprs(exprs []parser.Expr, schema *parser.Schema) ([]parser.Expr, error) {
+	validExprs := make([]parser.Expr, 0, len(exprs))
+
+	for _, expr := range exprs {
+		validExpr, err := validateExpr(expr, schema)
+		if err != nil {
+			return nil, err
+		}
+
+		validExprs = append(validExprs, validExpr)
+	}
+
+	return validExprs, nil
+}
+
+// located appends the location of the lexer, parser or validation error in input, when it
+// is known, to the message of err.
+func located(err error, input string) error {
+	var locatable interface {
+		Locate(source string) *lexer.Location
+	}
+
+	if errors.As(err, &locatable) {
+		if location := locatable.Locate(input); location != nil {
+			return fmt.Errorf("%w %s", err, location)
+		}
+	}
+
+	return err
+}
+
+// Parse parses input, the filter of a search, and validates it according to the schema of the
+// searched entity. The operands of the returned conjunction are *parser.ValidCompareExpr,
+// *parser.OrExpr, *parser.NotExpr or, for nested filters, *parser.AndExpr.
+func Parse(input string, schema *parser.Schema) (*parser.AndExpr, error) {
 	if input == "" {
-		return make([]*parser.ValidCompareExpr, 0), nil
+		return &parser.AndExpr{Exprs: make([]parser.Expr, 0)}, nil
 	}
 
 	tokens, err := lexer.Tokenize(&input)
 	if err != nil {
-		return nil, fmt.Errorf("error while lexing %s: %w", input, err)
+		return nil, located(fmt.Errorf("error while lexing %s: %w", input, err), input)
 	}
 
 	ast, err := parser.Parse(tokens)
 	if err != nil {
-		return nil, fmt.Errorf("error while parsing %s: %w", input, err)
+		return nil, located(fmt.Errorf("error while parsing %s: %w", input, err), input)
 	}
 
-	validExpressions := make([]*parser.ValidCompareExpr, 0, len(ast.Exprs))
-
-	for _, expr := range ast.Exprs {
-		ve, err := parser.ValidateExpression(expr)
-		if err != nil {
-			return nil, fmt.Errorf("error while validating %s: %w", input, err)
-		}
-
-		validExpressions = append(validExpressions, ve)
+	validExprs, err := validateExprs(ast.Exprs, schema)
+	if err != nil {
+		return nil, located(fmt.Errorf("error while validating %s: %w", input, err), input)
 	}
 
-	return validExpressions, nil
+	return &parser.AndExpr{Exprs: validExprs}, nil
+}
+
+// NewFilterError is the error of the searches for a filter which failed to parse. The details of
+// err, like the location of a typo, are part of the message as it is all the clients get.
+func NewFilterError(err error) *contract.Error {
+	return contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE, "error parsing search filter: "+err.Error())
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
 )
 
 func TestValidQueries(t *testing.T) {
@@ -21,6 +22,7 @@ func TestValidQueries(t *testing.T) {
 		"params.solver LIKE \"l%\"",
 		"datasets.digest IN ('77a19fc0')",
 		"attributes.run_id IN ('meh')",
+		"metrics.accuracy IS NULL AND params.lr IS NOT NULL AND tags.stage IS NULL",
 	}
 
 	for _, sample := range samples {
@@ -28,7 +30,7 @@ func TestValidQueries(t *testing.T) {
 		t.Run(currentSample, func(t *testing.T) {
 			t.Parallel()
 
-			_, err := query.ParseFilter(currentSample)
+			_, err := query.Parse(currentSample, parser.RunSchema)
 			if err != nil {
 				t.Errorf("unexpected parse error: %v", err)
 			}
@@ -56,9 +58,8 @@ func TestInvalidQueries(t *testing.T) {
 				"Valid keys are '[run_id run_name user_id status start_time end_time artifact_uri]'",
 		},
 		{
-			input: "datasets.foobar = 40",
-			expectedError: "Invalid dataset key '{foobar}' specified. " +
-				"Valid keys are '[run_id run_name user_id status start_time end_time artifact_uri]'",
+			input:         "datasets.foobar = 40",
+			expectedError: "Invalid dataset key '{foobar}' specified. Valid keys are '[name digest context]'",
 		},
 		{
 			input:         "metric.yow = 'z'",
@@ -88,6 +89,18 @@ func TestInvalidQueries(t *testing.T) {
 			input:         "datasets.context = 60",
 			expectedError: "expected datasets.context to be either a string or list of strings",
 		},
+		{
+			input:         "attributes.end_time IS NULL",
+			expectedError: "IS NULL and IS NOT NULL are only supported for metrics, parameters and tags",
+		},
+		{
+			input:         "metrics.accuracy IS 0.5",
+			expectedError: "expected NULL",
+		},
+		{
+			input:         "metrics.accuracy = NULL",
+			expectedError: "Expected NUMBER or STRING",
+		},
 	}
 
 	for _, sample := range samples {
@@ -95,7 +108,7 @@ func TestInvalidQueries(t *testing.T) {
 		t.Run(currentSample.input, func(t *testing.T) {
 			t.Parallel()
 
-			_, err := query.ParseFilter(currentSample.input)
+			_, err := query.Parse(currentSample.input, parser.RunSchema)
 			if err == nil {
 				t.Errorf("expected parse error but got nil")
 			}
@@ -110,3 +123,260 @@ func TestInvalidQueries(t *testing.T) {
 		})
 	}
 }
+
+func TestRegisteredModelQueries(t *testing.T) {
+	t.Parallel()
+
+	validSamples := []string{
+		"name = 'model'",
+		"attributes.name ILIKE '%model%'",
+		"tags.team LIKE 'ml-%' AND name != 'model'",
+		"tags.`mlflow.prompt.is_prompt` != 'true'",
+	}
+
+	for _, sample := range validSamples {
+		_, err := query.Parse(sample, parser.RegisteredModelSchema)
+		if err != nil {
+			t.Errorf("unexpected parse error for %q: %v", sample, err)
+		}
+	}
+
+	invalidSamples := []invalidSample{
+		{
+			input:         "params.name = 'model'",
+			expectedError: "invalid identifier \"params\"",
+		},
+		{
+			input:         "creation_timestamp = 'now'",
+			expectedError: "Invalid attribute key '{creation_timestamp}' specified",
+		},
+		{
+			input:         "name IN ('a', 'b')",
+			expectedError: "invalid comparator IN for attribute: name",
+		},
+		{
+			input:         "tags.team = 1",
+			expectedError: "expected a quoted string value for tag: team",
+		},
+	}
+
+	for _, sample := range invalidSamples {
+		_, err := query.Parse(sample.input, parser.RegisteredModelSchema)
+		if err == nil || !strings.Contains(err.Error(), sample.expectedError) {
+			t.Errorf("expected error to contain %q for %q, got %v", sample.expectedError, sample.input, err)
+		}
+	}
+}
+
+func TestModelVersionQueries(t *testing.T) {
+	t.Parallel()
+
+	validSamples := []string{
+		"name = 'model' AND version_number >= 2",
+		"run_id IN ('a1b2', 'c3d4')",
+		"source_path LIKE 's3://bucket/%'",
+		"tags.validated = 'true' AND current_stage != 'Archived'",
+	}
+
+	for _, sample := range validSamples {
+		_, err := query.Parse(sample, parser.ModelVersionSchema)
+		if err != nil {
+			t.Errorf("unexpected parse error for %q: %v", sample, err)
+		}
+	}
+
+	invalidSamples := []invalidSample{
+		{
+			input:         "version_number = '1'",
+			expectedError: "expected an integer value for attribute: version_number",
+		},
+		{
+			input:         "version_number LIKE 1",
+			expectedError: "invalid comparator LIKE for attribute: version_number",
+		},
+		{
+			input:         "run_id = 12",
+			expectedError: "expected a quoted string value for attribute: run_id",
+		},
+		{
+			input:         "source_path IN ('a', 'b')",
+			expectedError: "invalid comparator IN for attribute: source_path",
+		},
+		{
+			input:         "status = 'READY'",
+			expectedError: "Invalid attribute key '{status}' specified",
+		},
+	}
+
+	for _, sample := range invalidSamples {
+		_, err := query.Parse(sample.input, parser.ModelVersionSchema)
+		if err == nil || !strings.Contains(err.Error(), sample.expectedError) {
+			t.Errorf("expected error to contain %q for %q, got %v", sample.expectedError, sample.input, err)
+		}
+	}
+}
+
+func TestBooleanQueries(t *testing.T) {
+	t.Parallel()
+
+	_, err := query.Parse("(metrics.acc > 0.9 OR tags.stage = 'prod') AND NOT params.lr = '0.01'", parser.RunSchema)
+	if err != nil {
+		t.Errorf("unexpected parse error: %v", err)
+	}
+
+	_, err = query.Parse("metrics.acc > 0.9 OR metrics.loss = 'low'", parser.RunSchema)
+	if err == nil || !strings.Contains(err.Error(), "expected numeric value type for metric") {
+		t.Errorf("expected the comparisons within OR to be validated, got %v", err)
+	}
+
+	_, err = query.Parse("attributes.request_id IS NOT NULL", parser.TraceSchema)
+	if err == nil || !strings.Contains(err.Error(), "invalid comparator IS NOT NULL for attribute: request_id") {
+		t.Errorf("expected IS NOT NULL to be rejected by trace filters, got %v", err)
+	}
+
+	for _, schema := range []*parser.Schema{
+		parser.ExperimentSchema,
+		parser.TraceSchema,
+		parser.LoggedModelSchema,
+		parser.RegisteredModelSchema,
+		parser.ModelVersionSchema,
+	} {
+		_, err = query.Parse("tags.a = 'x' OR NOT (tags.b = 'y' AND tags.c LIKE 'z%')", schema)
+		if err != nil {
+			t.Errorf("unexpected parse error: %v", err)
+		}
+	}
+}
+
+func TestExperimentQueries(t *testing.T) {
+	t.Parallel()
+
+	validSamples := []string{
+		"name = 'my experiment'",
+		"attribute.name ILIKE '%exp%'",
+		"creation_time > 1700000000000",
+		"attributes.last_update_time <= 1700000000000",
+		"tags.team = 'ml' AND tag.`project name` != 'x'",
+		"name LIKE 'a%' OR tags.stage = 'prod'",
+	}
+
+	for _, sample := range validSamples {
+		if _, err := query.Parse(sample, parser.ExperimentSchema); err != nil {
+			t.Errorf("unexpected parse error for %q: %v", sample, err)
+		}
+	}
+
+	invalidSamples := []invalidSample{
+		{
+			input:         "creation_time = 1.5",
+			expectedError: "expected an integer value for attribute: creation_time. Found 1.500000",
+		},
+		{
+			input:         "creation_time LIKE '1'",
+			expectedError: "invalid comparator LIKE for attribute: creation_time",
+		},
+		{
+			input:         "name IN ('a', 'b')",
+			expectedError: "invalid comparator IN for attribute: name. Only '=', '!=', 'LIKE' and 'ILIKE' are supported",
+		},
+		{
+			input:         "experiment_name = 'a'",
+			expectedError: "Invalid attribute key '{experiment_name}' specified",
+		},
+		{
+			input:         "metrics.acc > 1",
+			expectedError: "invalid identifier \"metrics\"",
+		},
+		{
+			input:         "name = 'a' AND",
+			expectedError: "error while parsing",
+		},
+	}
+
+	for _, sample := range invalidSamples {
+		_, err := query.Parse(sample.input, parser.ExperimentSchema)
+		if err == nil || !strings.Contains(err.Error(), sample.expectedError) {
+			t.Errorf("expected error to contain %q for %q, got %v", sample.expectedError, sample.input, err)
+		}
+	}
+}
+
+// TestSchemaErrorMessages checks that the searches share their error messages, but for the runs
+// filter which keeps those of search_utils.py.
+func TestSchemaErrorMessages(t *testing.T) {
+	t.Parallel()
+
+	for _, schema := range []*parser.Schema{
+		parser.ExperimentSchema,
+		parser.TraceSchema,
+		parser.LoggedModelSchema,
+		parser.RegisteredModelSchema,
+		parser.ModelVersionSchema,
+	} {
+		_, err := query.Parse("tags.team = 1", schema)
+		if err == nil || !strings.HasSuffix(
+			err.Error(),
+			"expected a quoted string value for tag: team. Found 1.000000 at column 13 near \"1\":\n"+
+				"tags.team = 1\n"+
+				"            ^",
+		) {
+			t.Errorf("unexpected error for a tag compared with a number: %v", err)
+		}
+
+		_, err = query.Parse("tags.team < 'a'", schema)
+		if err == nil || !strings.Contains(
+			err.Error(),
+			"invalid comparator < for tag: team. Only '=', '!=', 'LIKE'",
+		) {
+			t.Errorf("unexpected error for an unsupported comparator: %v", err)
+		}
+	}
+}
+
+func TestFilterErrorLocation(t *testing.T) {
+	t.Parallel()
+
+	samples := []invalidSample{
+		{
+			input: "metrics.acc > 0.9 AND attribute.start_tme > 0",
+			expectedError: "Did you mean 'start_time'? at column 33 near \"start_tme\":\n" +
+				"metrics.acc > 0.9 AND attribute.start_tme > 0\n" +
+				"                                ^^^^^^^^^",
+		},
+		{
+			input: "metrc.acc > 0.9",
+			expectedError: "invalid identifier \"metrc\". Did you mean 'metric'? at column 1 near \"metrc\":\n" +
+				"metrc.acc > 0.9\n" +
+				"^^^^^",
+		},
+		{
+			input: "params.lr = 0.1",
+			expectedError: "expected a quoted string value for parameter. Found 0.100000 at column 13 near \"0.1\":\n" +
+				"params.lr = 0.1\n" +
+				"            ^^^",
+		},
+		{
+			input: "params.lr =",
+			expectedError: "Expected NUMBER or STRING, got eof at column 12:\n" +
+				"params.lr =\n" +
+				"           ^",
+		},
+	}
+
+	for _, sample := range samples {
+		_, err := query.Parse(sample.input, parser.RunSchema)
+		if err == nil || !strings.HasSuffix(err.Error(), sample.expectedError) {
+			t.Errorf("expected error to end with %q, got %v", sample.expectedError, err)
+		}
+	}
+
+	_, err := query.Parse("version_number LIKE '1'", parser.ModelVersionSchema)
+	if err == nil || !strings.HasSuffix(err.Error(), "near \"LIKE\":\nversion_number LIKE '1'\n               ^^^^") {
+		t.Errorf("expected the error to point at the comparator, got %v", err)
+	}
+
+	_, err = query.Parse("atribute.status = 'OK'", parser.TraceSchema)
+	if err == nil || !strings.Contains(err.Error(), "Did you mean 'attribute'?") {
+		t.Errorf("expected a suggestion for the misspelled identifier, got %v", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/mlflow/mlflow-go-backend/pkg/entities"
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
 
 func (ts TrackingService) SearchRuns(
@@ -23,7 +24,7 @@ func (ts TrackingService) SearchRuns(
 	maxResults := int(input.GetMaxResults())
 
 	runs, nextPageToken, err := ts.Store.SearchRuns(
-		ctx,
+		utils.NewContextWithReplicaReads(ctx),
 		input.GetExperimentIds(),
 		input.GetFilter(),
 		runViewType,
@@ -76,7 +77,7 @@ func (ts TrackingService) LogBatch(
 func (ts TrackingService) GetRun(
 	ctx context.Context, input *protos.GetRun,
 ) (*protos.GetRun_Response, *contract.Error) {
-	run, err := ts.Store.GetRun(ctx, input.GetRunId())
+	run, err := ts.Store.GetRun(utils.NewContextWithReplicaReads(ctx), input.GetRunId())
 	if err != nil {
 		return nil, err
 	}
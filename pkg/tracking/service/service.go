@@ -4,25 +4,40 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository"
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/resolver"
 	"github.com/mlflow/mlflow-go-backend/pkg/config"
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/buffer"
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql"
 )
 
+// ArtifactRepositoryFactory creates the repository rooted at the artifact URI of a run.
+type ArtifactRepositoryFactory func(artifactURI string) (repository.ArtifactRepository, error)
+
 type TrackingService struct {
-	config *config.Config
-	Store  store.TrackingStore
+	config                    *config.Config
+	Store                     store.TrackingStore
+	ArtifactRepositoryFactory ArtifactRepositoryFactory
 }
 
 func NewTrackingService(ctx context.Context, config *config.Config) (*TrackingService, error) {
-	store, err := sql.NewTrackingSQLStore(ctx, config)
+	sqlStore, err := sql.NewTrackingSQLStore(ctx, config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create new sql store: %w", err)
 	}
 
+	var trackingStore store.TrackingStore = sqlStore
+	if config.MetricBuffer.Window.Duration > 0 {
+		trackingStore = buffer.NewMetricBuffer(ctx, sqlStore, config.MetricBuffer, config.ShutdownTimeout.Duration)
+	}
+
 	return &TrackingService{
 		config: config,
-		Store:  store,
+		Store:  trackingStore,
+		ArtifactRepositoryFactory: func(artifactURI string) (repository.ArtifactRepository, error) {
+			return resolver.NewArtifactRepository(artifactURI, config.ArtifactsDestination) //nolint:wrapcheck
+		},
 	}, nil
 }
 
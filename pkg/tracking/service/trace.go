@@ -192,3 +192,104 @@ func (ts TrackingService) DeleteTraces(
 		TracesDeleted: utils.PtrTo(result),
 	}, nil
 }
+
+func (ts TrackingService) SearchTraces(
+	ctx context.Context, input *protos.SearchTraces,
+) (*protos.SearchTraces_Response, *contract.Error) {
+	traces, nextPageToken, err := ts.Store.SearchTraces(
+		ctx,
+		input.GetExperimentIds(),
+		input.GetFilter(),
+		"",
+		int(input.GetMaxResults()),
+		input.GetOrderBy(),
+		input.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.SearchTraces_Response{
+		Traces:        make([]*protos.TraceInfo, 0, len(traces)),
+		NextPageToken: &nextPageToken,
+	}
+
+	for _, trace := range traces {
+		response.Traces = append(response.Traces, trace.ToProto())
+	}
+
+	return &response, nil
+}
+
+func (ts TrackingService) SearchTracesV3(
+	ctx context.Context, input *protos.SearchTracesV3,
+) (*protos.SearchTracesV3_Response, *contract.Error) {
+	experimentIDs := make([]string, 0, len(input.GetLocations()))
+
+	for _, location := range input.GetLocations() {
+		if location.GetType() != protos.TraceLocation_MLFLOW_EXPERIMENT || location.GetMlflowExperiment() == nil {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"Unsupported trace location type %q. Only MLFLOW_EXPERIMENT locations can be searched.",
+					location.GetType(),
+				),
+			)
+		}
+
+		experimentIDs = append(experimentIDs, location.GetMlflowExperiment().GetExperimentId())
+	}
+
+	traces, nextPageToken, err := ts.Store.SearchTracesV3(
+		ctx,
+		experimentIDs,
+		input.GetFilter(),
+		int(input.GetMaxResults()),
+		input.GetOrderBy(),
+		input.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.SearchTracesV3_Response{
+		Traces:        make([]*protos.TraceInfoV3, 0, len(traces)),
+		NextPageToken: &nextPageToken,
+	}
+
+	for _, trace := range traces {
+		response.Traces = append(response.Traces, trace.ToProto())
+	}
+
+	return &response, nil
+}
+
+// SearchUnifiedTraceHandler only covers the traces stored in the tracking database,
+// online traces living in a SQL warehouse are not available to the OSS server.
+func (ts TrackingService) SearchUnifiedTraceHandler(
+	ctx context.Context, input *protos.SearchUnifiedTraces,
+) (*protos.SearchUnifiedTraces_Response, *contract.Error) {
+	traces, nextPageToken, err := ts.Store.SearchTraces(
+		ctx,
+		input.GetExperimentIds(),
+		input.GetFilter(),
+		input.GetModelId(),
+		int(input.GetMaxResults()),
+		input.GetOrderBy(),
+		input.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.SearchUnifiedTraces_Response{
+		Traces:        make([]*protos.TraceInfo, 0, len(traces)),
+		NextPageToken: &nextPageToken,
+	}
+
+	for _, trace := range traces {
+		response.Traces = append(response.Traces, trace.ToProto())
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,99 @@
+package service //nolint:testpackage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+func experimentLocation(experimentID string) *protos.TraceLocation {
+	return &protos.TraceLocation{
+		Type: utils.PtrTo(protos.TraceLocation_MLFLOW_EXPERIMENT),
+		Identifier: &protos.TraceLocation_MlflowExperiment{
+			MlflowExperiment: &protos.TraceLocation_MlflowExperimentLocation{
+				ExperimentId: utils.PtrTo(experimentID),
+			},
+		},
+	}
+}
+
+func TestSearchTracesV3(t *testing.T) {
+	t.Parallel()
+
+	trackingStore := store.NewMockTrackingStore(t)
+	trackingStore.EXPECT().SearchTracesV3(
+		context.Background(),
+		[]string{"1", "2"},
+		"trace.status = 'OK'",
+		100,
+		[]string(nil),
+		"",
+	).Return([]*entities.TraceInfoV3{
+		{RequestID: "tr-1", ExperimentID: "1", Status: "OK", ClientRequestID: utils.PtrTo("client")},
+	}, "", nil)
+
+	service := TrackingService{Store: trackingStore}
+
+	response, err := service.SearchTracesV3(context.Background(), &protos.SearchTracesV3{
+		Locations:  []*protos.TraceLocation{experimentLocation("1"), experimentLocation("2")},
+		Filter:     utils.PtrTo("trace.status = 'OK'"),
+		MaxResults: utils.PtrTo(int32(100)),
+	})
+	require.Nil(t, err)
+	require.Len(t, response.GetTraces(), 1)
+
+	trace := response.GetTraces()[0]
+	assert.Equal(t, "tr-1", trace.GetTraceId())
+	assert.Equal(t, protos.TraceInfoV3_OK, trace.GetState())
+	assert.Equal(t, "client", trace.GetClientRequestId())
+	assert.Equal(t, "1", trace.GetTraceLocation().GetMlflowExperiment().GetExperimentId())
+}
+
+func TestSearchTracesV3UnsupportedLocation(t *testing.T) {
+	t.Parallel()
+
+	service := TrackingService{Store: store.NewMockTrackingStore(t)}
+
+	_, err := service.SearchTracesV3(context.Background(), &protos.SearchTracesV3{
+		Locations: []*protos.TraceLocation{
+			{Type: utils.PtrTo(protos.TraceLocation_INFERENCE_TABLE)},
+		},
+	})
+	require.NotNil(t, err)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(err.Code))
+}
+
+func TestSearchUnifiedTracesOfModel(t *testing.T) {
+	t.Parallel()
+
+	// the filter is handed over as is, the model being a condition of its own.
+	trackingStore := store.NewMockTrackingStore(t)
+	trackingStore.EXPECT().SearchTraces(
+		context.Background(),
+		[]string{"1"},
+		"tags.a = 'x' OR tags.b = 'y'",
+		"m-1",
+		100,
+		[]string(nil),
+		"",
+	).Return([]*entities.TraceInfo{{RequestID: "tr-1", ExperimentID: "1"}}, "", nil)
+
+	service := TrackingService{Store: trackingStore}
+
+	response, err := service.SearchUnifiedTraceHandler(context.Background(), &protos.SearchUnifiedTraces{
+		ExperimentIds: []string{"1"},
+		Filter:        utils.PtrTo("tags.a = 'x' OR tags.b = 'y'"),
+		ModelId:       utils.PtrTo("m-1"),
+		MaxResults:    utils.PtrTo(int32(100)),
+	})
+	require.Nil(t, err)
+	require.Len(t, response.GetTraces(), 1)
+	assert.Equal(t, "tr-1", response.GetTraces()[0].GetRequestId())
+}
@@ -0,0 +1,205 @@
+package buffer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+var errNotDrained = errors.New("metric buffer was not drained")
+
+// entry is a metric buffered for a LogMetric call.
+type entry struct {
+	metric *entities.Metric
+	// written is closed once the metric is written, or failed to be with err.
+	written chan struct{}
+	err     *contract.Error
+}
+
+// batch holds the metrics of a run waiting to be written together.
+type batch struct {
+	runID   string
+	entries []*entry
+	timer   *time.Timer
+}
+
+// MetricBuffer is a tracking store coalescing the metrics logged one by one to a run with LogMetric
+// into LogBatch calls of the store it wraps, all its other calls going straight to that store.
+type MetricBuffer struct {
+	store.TrackingStore
+	options         config.MetricBufferConfig
+	shutdownTimeout time.Duration
+	logger          *logrus.Logger
+
+	mutex   sync.Mutex
+	batches map[string]*batch
+	closed  bool
+	writing sync.WaitGroup
+	// writeContext outlives the requests of the metrics, it is only cancelled by Destroy.
+	writeContext context.Context //nolint:containedctx
+	cancelWrites context.CancelFunc
+}
+
+// NewMetricBuffer buffers the metrics logged to trackingStore as configured by options. Destroy
+// writes the buffered metrics, waiting at most shutdownTimeout for them.
+func NewMetricBuffer(
+	ctx context.Context, trackingStore store.TrackingStore, options config.MetricBufferConfig,
+	shutdownTimeout time.Duration,
+) *MetricBuffer {
+	logger := utils.GetLoggerFromContext(ctx)
+	writeContext, cancelWrites := context.WithCancel(utils.NewContextWithLogger(context.Background(), logger))
+
+	return &MetricBuffer{
+		TrackingStore:   trackingStore,
+		options:         options,
+		shutdownTimeout: shutdownTimeout,
+		logger:          logger,
+		batches:         make(map[string]*batch),
+		writeContext:    writeContext,
+		cancelWrites:    cancelWrites,
+	}
+}
+
+// LogMetric adds metric to the batch of runID, written once it is full or after the configured
+// window. It returns once the metric is written, or right away in fire-and-forget mode. The write
+// does not depend on ctx, so it is awaited even once ctx is done rather than reporting a failure
+// for a metric which is still written.
+func (b *MetricBuffer) LogMetric(ctx context.Context, runID string, metric *entities.Metric) *contract.Error {
+	b.mutex.Lock()
+
+	if b.closed {
+		b.mutex.Unlock()
+
+		return b.TrackingStore.LogMetric(ctx, runID, metric)
+	}
+
+	current, ok := b.batches[runID]
+	if !ok {
+		current = &batch{runID: runID}
+		current.timer = time.AfterFunc(b.options.Window.Duration, func() {
+			b.mutex.Lock()
+			defer b.mutex.Unlock()
+
+			// the batch may already be written because it was full.
+			if b.batches[runID] == current {
+				b.write(current)
+			}
+		})
+		b.batches[runID] = current
+	}
+
+	buffered := &entry{metric: metric, written: make(chan struct{})}
+
+	current.entries = append(current.entries, buffered)
+	if len(current.entries) >= b.options.MaxSize {
+		b.write(current)
+	}
+
+	b.mutex.Unlock()
+
+	if b.options.FireAndForget {
+		return nil
+	}
+
+	<-buffered.written
+
+	return buffered.err
+}
+
+// write writes current in the background, no more metrics being added to it. It must be called with
+// the mutex held.
+func (b *MetricBuffer) write(current *batch) {
+	current.timer.Stop()
+	delete(b.batches, current.runID)
+
+	b.writing.Add(1)
+
+	go func() {
+		defer b.writing.Done()
+
+		b.writeBatch(current)
+	}()
+}
+
+// writeBatch writes the metrics of current with a single LogBatch. As a single metric at fault fails
+// the whole batch, the metrics of a failed batch are then written one by one, so that only the calls
+// of the metrics at fault fail.
+func (b *MetricBuffer) writeBatch(current *batch) {
+	metrics := make([]*entities.Metric, 0, len(current.entries))
+	for _, buffered := range current.entries {
+		metrics = append(metrics, buffered.metric)
+	}
+
+	err := b.TrackingStore.LogBatch(b.writeContext, current.runID, metrics, nil, nil)
+
+	failed := 0
+
+	for _, buffered := range current.entries {
+		switch {
+		case err == nil:
+		case len(current.entries) == 1:
+			buffered.err = err
+		default:
+			buffered.err = b.TrackingStore.LogMetric(b.writeContext, current.runID, buffered.metric)
+		}
+
+		if buffered.err != nil {
+			failed++
+			err = buffered.err
+		}
+
+		close(buffered.written)
+	}
+
+	if failed > 0 && b.options.FireAndForget {
+		b.logger.Errorf(
+			"Failed to write %d of %d buffered metrics of run %s: %v", failed, len(current.entries), current.runID, err,
+		)
+	}
+}
+
+// Destroy writes the buffered metrics, the next ones going straight to the store, then destroys
+// the store once they are written. The writes still running after the shutdown timeout are
+// cancelled, and awaited, before the store is destroyed.
+func (b *MetricBuffer) Destroy() error {
+	defer b.cancelWrites()
+
+	b.mutex.Lock()
+
+	b.closed = true
+	for _, current := range b.batches {
+		b.write(current)
+	}
+
+	b.mutex.Unlock()
+
+	drained := make(chan struct{})
+
+	go func() {
+		b.writing.Wait()
+		close(drained)
+	}()
+
+	var err error
+
+	select {
+	case <-drained:
+	case <-time.After(b.shutdownTimeout):
+		err = fmt.Errorf("%w within %s", errNotDrained, b.shutdownTimeout)
+
+		b.cancelWrites()
+		<-drained
+	}
+
+	return errors.Join(err, b.TrackingStore.Destroy())
+}
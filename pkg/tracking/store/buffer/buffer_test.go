@@ -0,0 +1,226 @@
+package buffer_test
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/buffer"
+	sqlstore "github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql"
+)
+
+func logMetrics(t *testing.T, metricBuffer *buffer.MetricBuffer, runID string, count int) []*contract.Error {
+	t.Helper()
+
+	errs := make([]*contract.Error, count)
+
+	var waitGroup sync.WaitGroup
+
+	for i := range count {
+		waitGroup.Add(1)
+
+		go func() {
+			defer waitGroup.Done()
+
+			errs[i] = metricBuffer.LogMetric(context.Background(), runID, &entities.Metric{Key: "loss", Step: int64(i)})
+		}()
+	}
+
+	waitGroup.Wait()
+
+	return errs
+}
+
+func TestMetricBufferWritesFullBatches(t *testing.T) {
+	t.Parallel()
+
+	trackingStore := store.NewMockTrackingStore(t)
+	trackingStore.EXPECT().LogBatch(
+		mock.Anything, "run", mock.MatchedBy(func(metrics []*entities.Metric) bool { return len(metrics) == 2 }),
+		[]*entities.Param(nil), []*entities.RunTag(nil),
+	).Return(nil).Twice()
+
+	metricBuffer := buffer.NewMetricBuffer(context.Background(), trackingStore, config.MetricBufferConfig{
+		Window: config.Duration{Duration: time.Hour}, MaxSize: 2,
+	}, time.Second)
+
+	for _, err := range logMetrics(t, metricBuffer, "run", 4) {
+		assert.Nil(t, err)
+	}
+}
+
+func TestMetricBufferReturnsWriteErrors(t *testing.T) {
+	t.Parallel()
+
+	writeErr := contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE, "invalid metric")
+
+	trackingStore := store.NewMockTrackingStore(t)
+	trackingStore.EXPECT().LogBatch(
+		mock.Anything, "run", mock.Anything, []*entities.Param(nil), []*entities.RunTag(nil),
+	).Return(writeErr).Once()
+	// the metrics of the failed batch are written one by one, only the one at fault failing.
+	trackingStore.EXPECT().LogMetric(mock.Anything, "run", mock.Anything).RunAndReturn(
+		func(_ context.Context, _ string, metric *entities.Metric) *contract.Error {
+			if metric.Step == 1 {
+				return writeErr
+			}
+
+			return nil
+		},
+	).Times(3)
+
+	metricBuffer := buffer.NewMetricBuffer(context.Background(), trackingStore, config.MetricBufferConfig{
+		Window: config.Duration{Duration: 10 * time.Millisecond}, MaxSize: 1000,
+	}, time.Second)
+
+	assert.Equal(t, []*contract.Error{nil, writeErr, nil}, logMetrics(t, metricBuffer, "run", 3))
+}
+
+func TestMetricBufferAwaitsWritesOfCancelledCalls(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+
+	trackingStore := store.NewMockTrackingStore(t)
+	trackingStore.EXPECT().LogBatch(
+		mock.Anything, "run", mock.Anything, []*entities.Param(nil), []*entities.RunTag(nil),
+	).RunAndReturn(func(
+		_ context.Context, _ string, _ []*entities.Metric, _ []*entities.Param, _ []*entities.RunTag,
+	) *contract.Error {
+		<-release
+
+		return nil
+	}).Once()
+
+	metricBuffer := buffer.NewMetricBuffer(context.Background(), trackingStore, config.MetricBufferConfig{
+		Window: config.Duration{Duration: time.Hour}, MaxSize: 1,
+	}, time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	time.AfterFunc(10*time.Millisecond, func() { close(release) })
+
+	// the metric is written although the call was cancelled, so it succeeds.
+	assert.Nil(t, metricBuffer.LogMetric(ctx, "run", &entities.Metric{Key: "loss"}))
+}
+
+func TestMetricBufferCancelsWritesBeforeDestroyingStore(t *testing.T) {
+	t.Parallel()
+
+	var cancelled atomic.Bool
+
+	trackingStore := store.NewMockTrackingStore(t)
+	trackingStore.EXPECT().LogBatch(
+		mock.Anything, "run", mock.Anything, []*entities.Param(nil), []*entities.RunTag(nil),
+	).RunAndReturn(func(
+		ctx context.Context, _ string, _ []*entities.Metric, _ []*entities.Param, _ []*entities.RunTag,
+	) *contract.Error {
+		<-ctx.Done()
+		cancelled.Store(true)
+
+		return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to log batch", ctx.Err())
+	}).Once()
+	trackingStore.EXPECT().Destroy().RunAndReturn(func() error {
+		assert.True(t, cancelled.Load(), "the store is destroyed under a running write")
+
+		return nil
+	}).Once()
+
+	metricBuffer := buffer.NewMetricBuffer(context.Background(), trackingStore, config.MetricBufferConfig{
+		Window: config.Duration{Duration: time.Hour}, MaxSize: 1000, FireAndForget: true,
+	}, 10*time.Millisecond)
+
+	assert.Nil(t, metricBuffer.LogMetric(context.Background(), "run", &entities.Metric{Key: "loss"}))
+	require.Error(t, metricBuffer.Destroy())
+}
+
+func TestMetricBufferDrainsOnDestroy(t *testing.T) {
+	t.Parallel()
+
+	var written []*entities.Metric
+
+	trackingStore := store.NewMockTrackingStore(t)
+	trackingStore.EXPECT().LogBatch(
+		mock.Anything, "run", mock.Anything, []*entities.Param(nil), []*entities.RunTag(nil),
+	).RunAndReturn(func(
+		_ context.Context, _ string, metrics []*entities.Metric, _ []*entities.Param, _ []*entities.RunTag,
+	) *contract.Error {
+		written = metrics
+
+		return nil
+	}).Once()
+	trackingStore.EXPECT().LogMetric(mock.Anything, "run", mock.Anything).Return(nil).Once()
+	trackingStore.EXPECT().Destroy().Return(nil).Once()
+
+	metricBuffer := buffer.NewMetricBuffer(context.Background(), trackingStore, config.MetricBufferConfig{
+		Window: config.Duration{Duration: time.Hour}, MaxSize: 1000, FireAndForget: true,
+	}, time.Second)
+
+	for _, err := range logMetrics(t, metricBuffer, "run", 3) {
+		assert.Nil(t, err)
+	}
+
+	require.NoError(t, metricBuffer.Destroy())
+	assert.Len(t, written, 3)
+
+	// once destroyed, the metrics go straight to the store.
+	assert.Nil(t, metricBuffer.LogMetric(context.Background(), "run", &entities.Metric{Key: "loss"}))
+}
+
+func TestMetricBufferWritesToSQLStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	uri := "sqlite:///" + filepath.Join(t.TempDir(), "mlflow.db")
+
+	database, err := sql.NewDatabase(ctx, uri, config.DatabaseConfig{})
+	require.NoError(t, err)
+	require.NoError(t, sql.Migrate(ctx, database, true))
+	require.NoError(t, sql.CloseDatabase(database))
+
+	trackingStore, err := sqlstore.NewTrackingSQLStore(ctx, &config.Config{TrackingStoreURI: uri})
+	require.NoError(t, err)
+
+	experimentID, contractError := trackingStore.CreateExperiment(ctx, "buffer", "", nil)
+	require.Nil(t, contractError)
+
+	active, contractError := trackingStore.CreateRun(ctx, experimentID, "user", 0, nil, "active")
+	require.Nil(t, contractError)
+
+	deleted, contractError := trackingStore.CreateRun(ctx, experimentID, "user", 0, nil, "deleted")
+	require.Nil(t, contractError)
+	require.Nil(t, trackingStore.DeleteRun(ctx, deleted.Info.RunID))
+
+	metricBuffer := buffer.NewMetricBuffer(ctx, trackingStore, config.MetricBufferConfig{
+		Window: config.Duration{Duration: 10 * time.Millisecond}, MaxSize: 4,
+	}, time.Second)
+
+	for _, err := range logMetrics(t, metricBuffer, active.Info.RunID, 10) {
+		assert.Nil(t, err)
+	}
+
+	for _, err := range logMetrics(t, metricBuffer, deleted.Info.RunID, 3) {
+		require.NotNil(t, err)
+		assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(err.Code))
+	}
+
+	metrics, _, contractError := trackingStore.GetMetricHistory(ctx, active.Info.RunID, "loss", "", nil)
+	require.Nil(t, contractError)
+	assert.Len(t, metrics, 10)
+
+	require.NoError(t, metricBuffer.Destroy())
+}
@@ -11,6 +11,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	protos "github.com/mlflow/mlflow-go-backend/pkg/protos"
+
+	time "time"
 )
 
 // MockTrackingStore is an autogenerated mock type for the TrackingStore type
@@ -26,6 +28,69 @@ func (_m *MockTrackingStore) EXPECT() *MockTrackingStore_Expecter {
 	return &MockTrackingStore_Expecter{mock: &_m.Mock}
 }
 
+// ClaimIdempotencyKey provides a mock function with given fields: ctx, key, requestHash, ttl
+func (_m *MockTrackingStore) ClaimIdempotencyKey(ctx context.Context, key string, requestHash string, ttl time.Duration) (*entities.IdempotentResponse, *contract.Error) {
+	ret := _m.Called(ctx, key, requestHash, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimIdempotencyKey")
+	}
+
+	var r0 *entities.IdempotentResponse
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (*entities.IdempotentResponse, *contract.Error)); ok {
+		return rf(ctx, key, requestHash, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) *entities.IdempotentResponse); ok {
+		r0 = rf(ctx, key, requestHash, ttl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.IdempotentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) *contract.Error); ok {
+		r1 = rf(ctx, key, requestHash, ttl)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_ClaimIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimIdempotencyKey'
+type MockTrackingStore_ClaimIdempotencyKey_Call struct {
+	*mock.Call
+}
+
+// ClaimIdempotencyKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - requestHash string
+//   - ttl time.Duration
+func (_e *MockTrackingStore_Expecter) ClaimIdempotencyKey(ctx interface{}, key interface{}, requestHash interface{}, ttl interface{}) *MockTrackingStore_ClaimIdempotencyKey_Call {
+	return &MockTrackingStore_ClaimIdempotencyKey_Call{Call: _e.mock.On("ClaimIdempotencyKey", ctx, key, requestHash, ttl)}
+}
+
+func (_c *MockTrackingStore_ClaimIdempotencyKey_Call) Run(run func(ctx context.Context, key string, requestHash string, ttl time.Duration)) *MockTrackingStore_ClaimIdempotencyKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_ClaimIdempotencyKey_Call) Return(_a0 *entities.IdempotentResponse, _a1 *contract.Error) *MockTrackingStore_ClaimIdempotencyKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_ClaimIdempotencyKey_Call) RunAndReturn(run func(context.Context, string, string, time.Duration) (*entities.IdempotentResponse, *contract.Error)) *MockTrackingStore_ClaimIdempotencyKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateExperiment provides a mock function with given fields: ctx, name, artifactLocation, tags
 func (_m *MockTrackingStore) CreateExperiment(ctx context.Context, name string, artifactLocation string, tags []*entities.ExperimentTag) (string, *contract.Error) {
 	ret := _m.Called(ctx, name, artifactLocation, tags)
@@ -87,6 +152,72 @@ func (_c *MockTrackingStore_CreateExperiment_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// CreateLoggedModel provides a mock function with given fields: ctx, experimentID, name, modelType, sourceRunID, params, tags
+func (_m *MockTrackingStore) CreateLoggedModel(ctx context.Context, experimentID string, name string, modelType *string, sourceRunID *string, params []*entities.LoggedModelParam, tags []*entities.LoggedModelTag) (*entities.LoggedModel, *contract.Error) {
+	ret := _m.Called(ctx, experimentID, name, modelType, sourceRunID, params, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLoggedModel")
+	}
+
+	var r0 *entities.LoggedModel
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *string, *string, []*entities.LoggedModelParam, []*entities.LoggedModelTag) (*entities.LoggedModel, *contract.Error)); ok {
+		return rf(ctx, experimentID, name, modelType, sourceRunID, params, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *string, *string, []*entities.LoggedModelParam, []*entities.LoggedModelTag) *entities.LoggedModel); ok {
+		r0 = rf(ctx, experimentID, name, modelType, sourceRunID, params, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.LoggedModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *string, *string, []*entities.LoggedModelParam, []*entities.LoggedModelTag) *contract.Error); ok {
+		r1 = rf(ctx, experimentID, name, modelType, sourceRunID, params, tags)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_CreateLoggedModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoggedModel'
+type MockTrackingStore_CreateLoggedModel_Call struct {
+	*mock.Call
+}
+
+// CreateLoggedModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - experimentID string
+//   - name string
+//   - modelType *string
+//   - sourceRunID *string
+//   - params []*entities.LoggedModelParam
+//   - tags []*entities.LoggedModelTag
+func (_e *MockTrackingStore_Expecter) CreateLoggedModel(ctx interface{}, experimentID interface{}, name interface{}, modelType interface{}, sourceRunID interface{}, params interface{}, tags interface{}) *MockTrackingStore_CreateLoggedModel_Call {
+	return &MockTrackingStore_CreateLoggedModel_Call{Call: _e.mock.On("CreateLoggedModel", ctx, experimentID, name, modelType, sourceRunID, params, tags)}
+}
+
+func (_c *MockTrackingStore_CreateLoggedModel_Call) Run(run func(ctx context.Context, experimentID string, name string, modelType *string, sourceRunID *string, params []*entities.LoggedModelParam, tags []*entities.LoggedModelTag)) *MockTrackingStore_CreateLoggedModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*string), args[4].(*string), args[5].([]*entities.LoggedModelParam), args[6].([]*entities.LoggedModelTag))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_CreateLoggedModel_Call) Return(_a0 *entities.LoggedModel, _a1 *contract.Error) *MockTrackingStore_CreateLoggedModel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_CreateLoggedModel_Call) RunAndReturn(run func(context.Context, string, string, *string, *string, []*entities.LoggedModelParam, []*entities.LoggedModelTag) (*entities.LoggedModel, *contract.Error)) *MockTrackingStore_CreateLoggedModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateRun provides a mock function with given fields: ctx, experimentID, userID, startTime, tags, runName
 func (_m *MockTrackingStore) CreateRun(ctx context.Context, experimentID string, userID string, startTime int64, tags []*entities.RunTag, runName string) (*entities.Run, *contract.Error) {
 	ret := _m.Called(ctx, experimentID, userID, startTime, tags, runName)
@@ -201,6 +332,164 @@ func (_c *MockTrackingStore_DeleteExperiment_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// DeleteExpiredIdempotencyKeys provides a mock function with given fields: ctx, ttl
+func (_m *MockTrackingStore) DeleteExpiredIdempotencyKeys(ctx context.Context, ttl time.Duration) (int64, *contract.Error) {
+	ret := _m.Called(ctx, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredIdempotencyKeys")
+	}
+
+	var r0 int64
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (int64, *contract.Error)); ok {
+		return rf(ctx, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) int64); ok {
+		r0 = rf(ctx, ttl)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) *contract.Error); ok {
+		r1 = rf(ctx, ttl)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_DeleteExpiredIdempotencyKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredIdempotencyKeys'
+type MockTrackingStore_DeleteExpiredIdempotencyKeys_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredIdempotencyKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ttl time.Duration
+func (_e *MockTrackingStore_Expecter) DeleteExpiredIdempotencyKeys(ctx interface{}, ttl interface{}) *MockTrackingStore_DeleteExpiredIdempotencyKeys_Call {
+	return &MockTrackingStore_DeleteExpiredIdempotencyKeys_Call{Call: _e.mock.On("DeleteExpiredIdempotencyKeys", ctx, ttl)}
+}
+
+func (_c *MockTrackingStore_DeleteExpiredIdempotencyKeys_Call) Run(run func(ctx context.Context, ttl time.Duration)) *MockTrackingStore_DeleteExpiredIdempotencyKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_DeleteExpiredIdempotencyKeys_Call) Return(_a0 int64, _a1 *contract.Error) *MockTrackingStore_DeleteExpiredIdempotencyKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_DeleteExpiredIdempotencyKeys_Call) RunAndReturn(run func(context.Context, time.Duration) (int64, *contract.Error)) *MockTrackingStore_DeleteExpiredIdempotencyKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLoggedModel provides a mock function with given fields: ctx, modelID
+func (_m *MockTrackingStore) DeleteLoggedModel(ctx context.Context, modelID string) *contract.Error {
+	ret := _m.Called(ctx, modelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLoggedModel")
+	}
+
+	var r0 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string) *contract.Error); ok {
+		r0 = rf(ctx, modelID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contract.Error)
+		}
+	}
+
+	return r0
+}
+
+// MockTrackingStore_DeleteLoggedModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLoggedModel'
+type MockTrackingStore_DeleteLoggedModel_Call struct {
+	*mock.Call
+}
+
+// DeleteLoggedModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - modelID string
+func (_e *MockTrackingStore_Expecter) DeleteLoggedModel(ctx interface{}, modelID interface{}) *MockTrackingStore_DeleteLoggedModel_Call {
+	return &MockTrackingStore_DeleteLoggedModel_Call{Call: _e.mock.On("DeleteLoggedModel", ctx, modelID)}
+}
+
+func (_c *MockTrackingStore_DeleteLoggedModel_Call) Run(run func(ctx context.Context, modelID string)) *MockTrackingStore_DeleteLoggedModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_DeleteLoggedModel_Call) Return(_a0 *contract.Error) *MockTrackingStore_DeleteLoggedModel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTrackingStore_DeleteLoggedModel_Call) RunAndReturn(run func(context.Context, string) *contract.Error) *MockTrackingStore_DeleteLoggedModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLoggedModelTag provides a mock function with given fields: ctx, modelID, key
+func (_m *MockTrackingStore) DeleteLoggedModelTag(ctx context.Context, modelID string, key string) *contract.Error {
+	ret := _m.Called(ctx, modelID, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLoggedModelTag")
+	}
+
+	var r0 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *contract.Error); ok {
+		r0 = rf(ctx, modelID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contract.Error)
+		}
+	}
+
+	return r0
+}
+
+// MockTrackingStore_DeleteLoggedModelTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLoggedModelTag'
+type MockTrackingStore_DeleteLoggedModelTag_Call struct {
+	*mock.Call
+}
+
+// DeleteLoggedModelTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - modelID string
+//   - key string
+func (_e *MockTrackingStore_Expecter) DeleteLoggedModelTag(ctx interface{}, modelID interface{}, key interface{}) *MockTrackingStore_DeleteLoggedModelTag_Call {
+	return &MockTrackingStore_DeleteLoggedModelTag_Call{Call: _e.mock.On("DeleteLoggedModelTag", ctx, modelID, key)}
+}
+
+func (_c *MockTrackingStore_DeleteLoggedModelTag_Call) Run(run func(ctx context.Context, modelID string, key string)) *MockTrackingStore_DeleteLoggedModelTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_DeleteLoggedModelTag_Call) Return(_a0 *contract.Error) *MockTrackingStore_DeleteLoggedModelTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTrackingStore_DeleteLoggedModelTag_Call) RunAndReturn(run func(context.Context, string, string) *contract.Error) *MockTrackingStore_DeleteLoggedModelTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteRun provides a mock function with given fields: ctx, runID
 func (_m *MockTrackingStore) DeleteRun(ctx context.Context, runID string) *contract.Error {
 	ret := _m.Called(ctx, runID)
@@ -519,29 +808,29 @@ func (_c *MockTrackingStore_EndTrace_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
-// GetExperiment provides a mock function with given fields: ctx, id
-func (_m *MockTrackingStore) GetExperiment(ctx context.Context, id string) (*entities.Experiment, *contract.Error) {
-	ret := _m.Called(ctx, id)
+// ExplainSearchExperiments provides a mock function with given fields: ctx, experimentViewType, maxResults, filter, orderBy, pageToken
+func (_m *MockTrackingStore) ExplainSearchExperiments(ctx context.Context, experimentViewType protos.ViewType, maxResults int64, filter string, orderBy []string, pageToken string) (*entities.SearchExplanation, *contract.Error) {
+	ret := _m.Called(ctx, experimentViewType, maxResults, filter, orderBy, pageToken)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetExperiment")
+		panic("no return value specified for ExplainSearchExperiments")
 	}
 
-	var r0 *entities.Experiment
+	var r0 *entities.SearchExplanation
 	var r1 *contract.Error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.Experiment, *contract.Error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, protos.ViewType, int64, string, []string, string) (*entities.SearchExplanation, *contract.Error)); ok {
+		return rf(ctx, experimentViewType, maxResults, filter, orderBy, pageToken)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.Experiment); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, protos.ViewType, int64, string, []string, string) *entities.SearchExplanation); ok {
+		r0 = rf(ctx, experimentViewType, maxResults, filter, orderBy, pageToken)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.Experiment)
+			r0 = ret.Get(0).(*entities.SearchExplanation)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) *contract.Error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, protos.ViewType, int64, string, []string, string) *contract.Error); ok {
+		r1 = rf(ctx, experimentViewType, maxResults, filter, orderBy, pageToken)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*contract.Error)
@@ -551,58 +840,62 @@ func (_m *MockTrackingStore) GetExperiment(ctx context.Context, id string) (*ent
 	return r0, r1
 }
 
-// MockTrackingStore_GetExperiment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExperiment'
-type MockTrackingStore_GetExperiment_Call struct {
+// MockTrackingStore_ExplainSearchExperiments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExplainSearchExperiments'
+type MockTrackingStore_ExplainSearchExperiments_Call struct {
 	*mock.Call
 }
 
-// GetExperiment is a helper method to define mock.On call
+// ExplainSearchExperiments is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockTrackingStore_Expecter) GetExperiment(ctx interface{}, id interface{}) *MockTrackingStore_GetExperiment_Call {
-	return &MockTrackingStore_GetExperiment_Call{Call: _e.mock.On("GetExperiment", ctx, id)}
+//   - experimentViewType protos.ViewType
+//   - maxResults int64
+//   - filter string
+//   - orderBy []string
+//   - pageToken string
+func (_e *MockTrackingStore_Expecter) ExplainSearchExperiments(ctx interface{}, experimentViewType interface{}, maxResults interface{}, filter interface{}, orderBy interface{}, pageToken interface{}) *MockTrackingStore_ExplainSearchExperiments_Call {
+	return &MockTrackingStore_ExplainSearchExperiments_Call{Call: _e.mock.On("ExplainSearchExperiments", ctx, experimentViewType, maxResults, filter, orderBy, pageToken)}
 }
 
-func (_c *MockTrackingStore_GetExperiment_Call) Run(run func(ctx context.Context, id string)) *MockTrackingStore_GetExperiment_Call {
+func (_c *MockTrackingStore_ExplainSearchExperiments_Call) Run(run func(ctx context.Context, experimentViewType protos.ViewType, maxResults int64, filter string, orderBy []string, pageToken string)) *MockTrackingStore_ExplainSearchExperiments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(protos.ViewType), args[2].(int64), args[3].(string), args[4].([]string), args[5].(string))
 	})
 	return _c
 }
 
-func (_c *MockTrackingStore_GetExperiment_Call) Return(_a0 *entities.Experiment, _a1 *contract.Error) *MockTrackingStore_GetExperiment_Call {
+func (_c *MockTrackingStore_ExplainSearchExperiments_Call) Return(_a0 *entities.SearchExplanation, _a1 *contract.Error) *MockTrackingStore_ExplainSearchExperiments_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTrackingStore_GetExperiment_Call) RunAndReturn(run func(context.Context, string) (*entities.Experiment, *contract.Error)) *MockTrackingStore_GetExperiment_Call {
+func (_c *MockTrackingStore_ExplainSearchExperiments_Call) RunAndReturn(run func(context.Context, protos.ViewType, int64, string, []string, string) (*entities.SearchExplanation, *contract.Error)) *MockTrackingStore_ExplainSearchExperiments_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetExperimentByName provides a mock function with given fields: ctx, name
-func (_m *MockTrackingStore) GetExperimentByName(ctx context.Context, name string) (*entities.Experiment, *contract.Error) {
-	ret := _m.Called(ctx, name)
+// ExplainSearchRuns provides a mock function with given fields: ctx, experimentIDs, filter, runViewType, maxResults, orderBy, pageToken
+func (_m *MockTrackingStore) ExplainSearchRuns(ctx context.Context, experimentIDs []string, filter string, runViewType protos.ViewType, maxResults int, orderBy []string, pageToken string) (*entities.SearchExplanation, *contract.Error) {
+	ret := _m.Called(ctx, experimentIDs, filter, runViewType, maxResults, orderBy, pageToken)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetExperimentByName")
+		panic("no return value specified for ExplainSearchRuns")
 	}
 
-	var r0 *entities.Experiment
+	var r0 *entities.SearchExplanation
 	var r1 *contract.Error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.Experiment, *contract.Error)); ok {
-		return rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, protos.ViewType, int, []string, string) (*entities.SearchExplanation, *contract.Error)); ok {
+		return rf(ctx, experimentIDs, filter, runViewType, maxResults, orderBy, pageToken)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.Experiment); ok {
-		r0 = rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, protos.ViewType, int, []string, string) *entities.SearchExplanation); ok {
+		r0 = rf(ctx, experimentIDs, filter, runViewType, maxResults, orderBy, pageToken)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.Experiment)
+			r0 = ret.Get(0).(*entities.SearchExplanation)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) *contract.Error); ok {
-		r1 = rf(ctx, name)
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, protos.ViewType, int, []string, string) *contract.Error); ok {
+		r1 = rf(ctx, experimentIDs, filter, runViewType, maxResults, orderBy, pageToken)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*contract.Error)
@@ -612,36 +905,351 @@ func (_m *MockTrackingStore) GetExperimentByName(ctx context.Context, name strin
 	return r0, r1
 }
 
-// MockTrackingStore_GetExperimentByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExperimentByName'
-type MockTrackingStore_GetExperimentByName_Call struct {
+// MockTrackingStore_ExplainSearchRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExplainSearchRuns'
+type MockTrackingStore_ExplainSearchRuns_Call struct {
 	*mock.Call
 }
 
-// GetExperimentByName is a helper method to define mock.On call
+// ExplainSearchRuns is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-func (_e *MockTrackingStore_Expecter) GetExperimentByName(ctx interface{}, name interface{}) *MockTrackingStore_GetExperimentByName_Call {
-	return &MockTrackingStore_GetExperimentByName_Call{Call: _e.mock.On("GetExperimentByName", ctx, name)}
+//   - experimentIDs []string
+//   - filter string
+//   - runViewType protos.ViewType
+//   - maxResults int
+//   - orderBy []string
+//   - pageToken string
+func (_e *MockTrackingStore_Expecter) ExplainSearchRuns(ctx interface{}, experimentIDs interface{}, filter interface{}, runViewType interface{}, maxResults interface{}, orderBy interface{}, pageToken interface{}) *MockTrackingStore_ExplainSearchRuns_Call {
+	return &MockTrackingStore_ExplainSearchRuns_Call{Call: _e.mock.On("ExplainSearchRuns", ctx, experimentIDs, filter, runViewType, maxResults, orderBy, pageToken)}
 }
 
-func (_c *MockTrackingStore_GetExperimentByName_Call) Run(run func(ctx context.Context, name string)) *MockTrackingStore_GetExperimentByName_Call {
+func (_c *MockTrackingStore_ExplainSearchRuns_Call) Run(run func(ctx context.Context, experimentIDs []string, filter string, runViewType protos.ViewType, maxResults int, orderBy []string, pageToken string)) *MockTrackingStore_ExplainSearchRuns_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].([]string), args[2].(string), args[3].(protos.ViewType), args[4].(int), args[5].([]string), args[6].(string))
 	})
 	return _c
 }
 
-func (_c *MockTrackingStore_GetExperimentByName_Call) Return(_a0 *entities.Experiment, _a1 *contract.Error) *MockTrackingStore_GetExperimentByName_Call {
+func (_c *MockTrackingStore_ExplainSearchRuns_Call) Return(_a0 *entities.SearchExplanation, _a1 *contract.Error) *MockTrackingStore_ExplainSearchRuns_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTrackingStore_GetExperimentByName_Call) RunAndReturn(run func(context.Context, string) (*entities.Experiment, *contract.Error)) *MockTrackingStore_GetExperimentByName_Call {
+func (_c *MockTrackingStore_ExplainSearchRuns_Call) RunAndReturn(run func(context.Context, []string, string, protos.ViewType, int, []string, string) (*entities.SearchExplanation, *contract.Error)) *MockTrackingStore_ExplainSearchRuns_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMetricHistory provides a mock function with given fields: ctx, runID, metricKey, pageToken, maxResults
+// ExplainSearchTraces provides a mock function with given fields: ctx, experimentIDs, filter, maxResults, orderBy, pageToken
+func (_m *MockTrackingStore) ExplainSearchTraces(ctx context.Context, experimentIDs []string, filter string, maxResults int, orderBy []string, pageToken string) (*entities.SearchExplanation, *contract.Error) {
+	ret := _m.Called(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExplainSearchTraces")
+	}
+
+	var r0 *entities.SearchExplanation
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, int, []string, string) (*entities.SearchExplanation, *contract.Error)); ok {
+		return rf(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, int, []string, string) *entities.SearchExplanation); ok {
+		r0 = rf(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.SearchExplanation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, int, []string, string) *contract.Error); ok {
+		r1 = rf(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_ExplainSearchTraces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExplainSearchTraces'
+type MockTrackingStore_ExplainSearchTraces_Call struct {
+	*mock.Call
+}
+
+// ExplainSearchTraces is a helper method to define mock.On call
+//   - ctx context.Context
+//   - experimentIDs []string
+//   - filter string
+//   - maxResults int
+//   - orderBy []string
+//   - pageToken string
+func (_e *MockTrackingStore_Expecter) ExplainSearchTraces(ctx interface{}, experimentIDs interface{}, filter interface{}, maxResults interface{}, orderBy interface{}, pageToken interface{}) *MockTrackingStore_ExplainSearchTraces_Call {
+	return &MockTrackingStore_ExplainSearchTraces_Call{Call: _e.mock.On("ExplainSearchTraces", ctx, experimentIDs, filter, maxResults, orderBy, pageToken)}
+}
+
+func (_c *MockTrackingStore_ExplainSearchTraces_Call) Run(run func(ctx context.Context, experimentIDs []string, filter string, maxResults int, orderBy []string, pageToken string)) *MockTrackingStore_ExplainSearchTraces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string), args[3].(int), args[4].([]string), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_ExplainSearchTraces_Call) Return(_a0 *entities.SearchExplanation, _a1 *contract.Error) *MockTrackingStore_ExplainSearchTraces_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_ExplainSearchTraces_Call) RunAndReturn(run func(context.Context, []string, string, int, []string, string) (*entities.SearchExplanation, *contract.Error)) *MockTrackingStore_ExplainSearchTraces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinalizeLoggedModel provides a mock function with given fields: ctx, modelID, status
+func (_m *MockTrackingStore) FinalizeLoggedModel(ctx context.Context, modelID string, status string) (*entities.LoggedModel, *contract.Error) {
+	ret := _m.Called(ctx, modelID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinalizeLoggedModel")
+	}
+
+	var r0 *entities.LoggedModel
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entities.LoggedModel, *contract.Error)); ok {
+		return rf(ctx, modelID, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entities.LoggedModel); ok {
+		r0 = rf(ctx, modelID, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.LoggedModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) *contract.Error); ok {
+		r1 = rf(ctx, modelID, status)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_FinalizeLoggedModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinalizeLoggedModel'
+type MockTrackingStore_FinalizeLoggedModel_Call struct {
+	*mock.Call
+}
+
+// FinalizeLoggedModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - modelID string
+//   - status string
+func (_e *MockTrackingStore_Expecter) FinalizeLoggedModel(ctx interface{}, modelID interface{}, status interface{}) *MockTrackingStore_FinalizeLoggedModel_Call {
+	return &MockTrackingStore_FinalizeLoggedModel_Call{Call: _e.mock.On("FinalizeLoggedModel", ctx, modelID, status)}
+}
+
+func (_c *MockTrackingStore_FinalizeLoggedModel_Call) Run(run func(ctx context.Context, modelID string, status string)) *MockTrackingStore_FinalizeLoggedModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_FinalizeLoggedModel_Call) Return(_a0 *entities.LoggedModel, _a1 *contract.Error) *MockTrackingStore_FinalizeLoggedModel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_FinalizeLoggedModel_Call) RunAndReturn(run func(context.Context, string, string) (*entities.LoggedModel, *contract.Error)) *MockTrackingStore_FinalizeLoggedModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExperiment provides a mock function with given fields: ctx, id
+func (_m *MockTrackingStore) GetExperiment(ctx context.Context, id string) (*entities.Experiment, *contract.Error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExperiment")
+	}
+
+	var r0 *entities.Experiment
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.Experiment, *contract.Error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.Experiment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Experiment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *contract.Error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_GetExperiment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExperiment'
+type MockTrackingStore_GetExperiment_Call struct {
+	*mock.Call
+}
+
+// GetExperiment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockTrackingStore_Expecter) GetExperiment(ctx interface{}, id interface{}) *MockTrackingStore_GetExperiment_Call {
+	return &MockTrackingStore_GetExperiment_Call{Call: _e.mock.On("GetExperiment", ctx, id)}
+}
+
+func (_c *MockTrackingStore_GetExperiment_Call) Run(run func(ctx context.Context, id string)) *MockTrackingStore_GetExperiment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_GetExperiment_Call) Return(_a0 *entities.Experiment, _a1 *contract.Error) *MockTrackingStore_GetExperiment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_GetExperiment_Call) RunAndReturn(run func(context.Context, string) (*entities.Experiment, *contract.Error)) *MockTrackingStore_GetExperiment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExperimentByName provides a mock function with given fields: ctx, name
+func (_m *MockTrackingStore) GetExperimentByName(ctx context.Context, name string) (*entities.Experiment, *contract.Error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExperimentByName")
+	}
+
+	var r0 *entities.Experiment
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.Experiment, *contract.Error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.Experiment); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Experiment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *contract.Error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_GetExperimentByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExperimentByName'
+type MockTrackingStore_GetExperimentByName_Call struct {
+	*mock.Call
+}
+
+// GetExperimentByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockTrackingStore_Expecter) GetExperimentByName(ctx interface{}, name interface{}) *MockTrackingStore_GetExperimentByName_Call {
+	return &MockTrackingStore_GetExperimentByName_Call{Call: _e.mock.On("GetExperimentByName", ctx, name)}
+}
+
+func (_c *MockTrackingStore_GetExperimentByName_Call) Run(run func(ctx context.Context, name string)) *MockTrackingStore_GetExperimentByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_GetExperimentByName_Call) Return(_a0 *entities.Experiment, _a1 *contract.Error) *MockTrackingStore_GetExperimentByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_GetExperimentByName_Call) RunAndReturn(run func(context.Context, string) (*entities.Experiment, *contract.Error)) *MockTrackingStore_GetExperimentByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoggedModel provides a mock function with given fields: ctx, modelID
+func (_m *MockTrackingStore) GetLoggedModel(ctx context.Context, modelID string) (*entities.LoggedModel, *contract.Error) {
+	ret := _m.Called(ctx, modelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoggedModel")
+	}
+
+	var r0 *entities.LoggedModel
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.LoggedModel, *contract.Error)); ok {
+		return rf(ctx, modelID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.LoggedModel); ok {
+		r0 = rf(ctx, modelID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.LoggedModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *contract.Error); ok {
+		r1 = rf(ctx, modelID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_GetLoggedModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoggedModel'
+type MockTrackingStore_GetLoggedModel_Call struct {
+	*mock.Call
+}
+
+// GetLoggedModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - modelID string
+func (_e *MockTrackingStore_Expecter) GetLoggedModel(ctx interface{}, modelID interface{}) *MockTrackingStore_GetLoggedModel_Call {
+	return &MockTrackingStore_GetLoggedModel_Call{Call: _e.mock.On("GetLoggedModel", ctx, modelID)}
+}
+
+func (_c *MockTrackingStore_GetLoggedModel_Call) Run(run func(ctx context.Context, modelID string)) *MockTrackingStore_GetLoggedModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_GetLoggedModel_Call) Return(_a0 *entities.LoggedModel, _a1 *contract.Error) *MockTrackingStore_GetLoggedModel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_GetLoggedModel_Call) RunAndReturn(run func(context.Context, string) (*entities.LoggedModel, *contract.Error)) *MockTrackingStore_GetLoggedModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetricHistory provides a mock function with given fields: ctx, runID, metricKey, pageToken, maxResults
 func (_m *MockTrackingStore) GetMetricHistory(ctx context.Context, runID string, metricKey string, pageToken string, maxResults *int32) ([]*entities.Metric, string, *contract.Error) {
 	ret := _m.Called(ctx, runID, metricKey, pageToken, maxResults)
 
@@ -712,6 +1320,71 @@ func (_c *MockTrackingStore_GetMetricHistory_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// GetMetricHistoryBulkInterval provides a mock function with given fields: ctx, runIDs, metricKey, startStep, endStep, maxResults
+func (_m *MockTrackingStore) GetMetricHistoryBulkInterval(ctx context.Context, runIDs []string, metricKey string, startStep *int64, endStep *int64, maxResults int) ([]*entities.MetricWithRunID, *contract.Error) {
+	ret := _m.Called(ctx, runIDs, metricKey, startStep, endStep, maxResults)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetricHistoryBulkInterval")
+	}
+
+	var r0 []*entities.MetricWithRunID
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, *int64, *int64, int) ([]*entities.MetricWithRunID, *contract.Error)); ok {
+		return rf(ctx, runIDs, metricKey, startStep, endStep, maxResults)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, *int64, *int64, int) []*entities.MetricWithRunID); ok {
+		r0 = rf(ctx, runIDs, metricKey, startStep, endStep, maxResults)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.MetricWithRunID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, *int64, *int64, int) *contract.Error); ok {
+		r1 = rf(ctx, runIDs, metricKey, startStep, endStep, maxResults)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_GetMetricHistoryBulkInterval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetricHistoryBulkInterval'
+type MockTrackingStore_GetMetricHistoryBulkInterval_Call struct {
+	*mock.Call
+}
+
+// GetMetricHistoryBulkInterval is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runIDs []string
+//   - metricKey string
+//   - startStep *int64
+//   - endStep *int64
+//   - maxResults int
+func (_e *MockTrackingStore_Expecter) GetMetricHistoryBulkInterval(ctx interface{}, runIDs interface{}, metricKey interface{}, startStep interface{}, endStep interface{}, maxResults interface{}) *MockTrackingStore_GetMetricHistoryBulkInterval_Call {
+	return &MockTrackingStore_GetMetricHistoryBulkInterval_Call{Call: _e.mock.On("GetMetricHistoryBulkInterval", ctx, runIDs, metricKey, startStep, endStep, maxResults)}
+}
+
+func (_c *MockTrackingStore_GetMetricHistoryBulkInterval_Call) Run(run func(ctx context.Context, runIDs []string, metricKey string, startStep *int64, endStep *int64, maxResults int)) *MockTrackingStore_GetMetricHistoryBulkInterval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string), args[3].(*int64), args[4].(*int64), args[5].(int))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_GetMetricHistoryBulkInterval_Call) Return(_a0 []*entities.MetricWithRunID, _a1 *contract.Error) *MockTrackingStore_GetMetricHistoryBulkInterval_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_GetMetricHistoryBulkInterval_Call) RunAndReturn(run func(context.Context, []string, string, *int64, *int64, int) ([]*entities.MetricWithRunID, *contract.Error)) *MockTrackingStore_GetMetricHistoryBulkInterval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRun provides a mock function with given fields: ctx, runID
 func (_m *MockTrackingStore) GetRun(ctx context.Context, runID string) (*entities.Run, *contract.Error) {
 	ret := _m.Called(ctx, runID)
@@ -773,6 +1446,65 @@ func (_c *MockTrackingStore_GetRun_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetRunArtifactURI provides a mock function with given fields: ctx, runID
+func (_m *MockTrackingStore) GetRunArtifactURI(ctx context.Context, runID string) (string, *contract.Error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunArtifactURI")
+	}
+
+	var r0 string
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, *contract.Error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *contract.Error); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_GetRunArtifactURI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunArtifactURI'
+type MockTrackingStore_GetRunArtifactURI_Call struct {
+	*mock.Call
+}
+
+// GetRunArtifactURI is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID string
+func (_e *MockTrackingStore_Expecter) GetRunArtifactURI(ctx interface{}, runID interface{}) *MockTrackingStore_GetRunArtifactURI_Call {
+	return &MockTrackingStore_GetRunArtifactURI_Call{Call: _e.mock.On("GetRunArtifactURI", ctx, runID)}
+}
+
+func (_c *MockTrackingStore_GetRunArtifactURI_Call) Run(run func(ctx context.Context, runID string)) *MockTrackingStore_GetRunArtifactURI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_GetRunArtifactURI_Call) Return(_a0 string, _a1 *contract.Error) *MockTrackingStore_GetRunArtifactURI_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_GetRunArtifactURI_Call) RunAndReturn(run func(context.Context, string) (string, *contract.Error)) *MockTrackingStore_GetRunArtifactURI_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRunTag provides a mock function with given fields: ctx, runID, tagKey
 func (_m *MockTrackingStore) GetRunTag(ctx context.Context, runID string, tagKey string) (*entities.RunTag, *contract.Error) {
 	ret := _m.Called(ctx, runID, tagKey)
@@ -1117,22 +1849,233 @@ func (_c *MockTrackingStore_LogInputs_Call) Return(_a0 *contract.Error) *MockTra
 	return _c
 }
 
-func (_c *MockTrackingStore_LogInputs_Call) RunAndReturn(run func(context.Context, string, []*entities.ModelInput, []*entities.DatasetInput) *contract.Error) *MockTrackingStore_LogInputs_Call {
+func (_c *MockTrackingStore_LogInputs_Call) RunAndReturn(run func(context.Context, string, []*entities.ModelInput, []*entities.DatasetInput) *contract.Error) *MockTrackingStore_LogInputs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogLoggedModelParams provides a mock function with given fields: ctx, modelID, params
+func (_m *MockTrackingStore) LogLoggedModelParams(ctx context.Context, modelID string, params []*entities.LoggedModelParam) *contract.Error {
+	ret := _m.Called(ctx, modelID, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogLoggedModelParams")
+	}
+
+	var r0 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*entities.LoggedModelParam) *contract.Error); ok {
+		r0 = rf(ctx, modelID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contract.Error)
+		}
+	}
+
+	return r0
+}
+
+// MockTrackingStore_LogLoggedModelParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogLoggedModelParams'
+type MockTrackingStore_LogLoggedModelParams_Call struct {
+	*mock.Call
+}
+
+// LogLoggedModelParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - modelID string
+//   - params []*entities.LoggedModelParam
+func (_e *MockTrackingStore_Expecter) LogLoggedModelParams(ctx interface{}, modelID interface{}, params interface{}) *MockTrackingStore_LogLoggedModelParams_Call {
+	return &MockTrackingStore_LogLoggedModelParams_Call{Call: _e.mock.On("LogLoggedModelParams", ctx, modelID, params)}
+}
+
+func (_c *MockTrackingStore_LogLoggedModelParams_Call) Run(run func(ctx context.Context, modelID string, params []*entities.LoggedModelParam)) *MockTrackingStore_LogLoggedModelParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]*entities.LoggedModelParam))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_LogLoggedModelParams_Call) Return(_a0 *contract.Error) *MockTrackingStore_LogLoggedModelParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTrackingStore_LogLoggedModelParams_Call) RunAndReturn(run func(context.Context, string, []*entities.LoggedModelParam) *contract.Error) *MockTrackingStore_LogLoggedModelParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogMetric provides a mock function with given fields: ctx, runID, metric
+func (_m *MockTrackingStore) LogMetric(ctx context.Context, runID string, metric *entities.Metric) *contract.Error {
+	ret := _m.Called(ctx, runID, metric)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogMetric")
+	}
+
+	var r0 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *entities.Metric) *contract.Error); ok {
+		r0 = rf(ctx, runID, metric)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contract.Error)
+		}
+	}
+
+	return r0
+}
+
+// MockTrackingStore_LogMetric_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogMetric'
+type MockTrackingStore_LogMetric_Call struct {
+	*mock.Call
+}
+
+// LogMetric is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID string
+//   - metric *entities.Metric
+func (_e *MockTrackingStore_Expecter) LogMetric(ctx interface{}, runID interface{}, metric interface{}) *MockTrackingStore_LogMetric_Call {
+	return &MockTrackingStore_LogMetric_Call{Call: _e.mock.On("LogMetric", ctx, runID, metric)}
+}
+
+func (_c *MockTrackingStore_LogMetric_Call) Run(run func(ctx context.Context, runID string, metric *entities.Metric)) *MockTrackingStore_LogMetric_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*entities.Metric))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_LogMetric_Call) Return(_a0 *contract.Error) *MockTrackingStore_LogMetric_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTrackingStore_LogMetric_Call) RunAndReturn(run func(context.Context, string, *entities.Metric) *contract.Error) *MockTrackingStore_LogMetric_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogParam provides a mock function with given fields: ctx, runID, metric
+func (_m *MockTrackingStore) LogParam(ctx context.Context, runID string, metric *entities.Param) *contract.Error {
+	ret := _m.Called(ctx, runID, metric)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogParam")
+	}
+
+	var r0 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *entities.Param) *contract.Error); ok {
+		r0 = rf(ctx, runID, metric)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contract.Error)
+		}
+	}
+
+	return r0
+}
+
+// MockTrackingStore_LogParam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogParam'
+type MockTrackingStore_LogParam_Call struct {
+	*mock.Call
+}
+
+// LogParam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID string
+//   - metric *entities.Param
+func (_e *MockTrackingStore_Expecter) LogParam(ctx interface{}, runID interface{}, metric interface{}) *MockTrackingStore_LogParam_Call {
+	return &MockTrackingStore_LogParam_Call{Call: _e.mock.On("LogParam", ctx, runID, metric)}
+}
+
+func (_c *MockTrackingStore_LogParam_Call) Run(run func(ctx context.Context, runID string, metric *entities.Param)) *MockTrackingStore_LogParam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*entities.Param))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_LogParam_Call) Return(_a0 *contract.Error) *MockTrackingStore_LogParam_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTrackingStore_LogParam_Call) RunAndReturn(run func(context.Context, string, *entities.Param) *contract.Error) *MockTrackingStore_LogParam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReapStaleRuns provides a mock function with given fields: ctx, defaultTimeout
+func (_m *MockTrackingStore) ReapStaleRuns(ctx context.Context, defaultTimeout time.Duration) ([]string, *contract.Error) {
+	ret := _m.Called(ctx, defaultTimeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReapStaleRuns")
+	}
+
+	var r0 []string
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) ([]string, *contract.Error)); ok {
+		return rf(ctx, defaultTimeout)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) []string); ok {
+		r0 = rf(ctx, defaultTimeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) *contract.Error); ok {
+		r1 = rf(ctx, defaultTimeout)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_ReapStaleRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReapStaleRuns'
+type MockTrackingStore_ReapStaleRuns_Call struct {
+	*mock.Call
+}
+
+// ReapStaleRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - defaultTimeout time.Duration
+func (_e *MockTrackingStore_Expecter) ReapStaleRuns(ctx interface{}, defaultTimeout interface{}) *MockTrackingStore_ReapStaleRuns_Call {
+	return &MockTrackingStore_ReapStaleRuns_Call{Call: _e.mock.On("ReapStaleRuns", ctx, defaultTimeout)}
+}
+
+func (_c *MockTrackingStore_ReapStaleRuns_Call) Run(run func(ctx context.Context, defaultTimeout time.Duration)) *MockTrackingStore_ReapStaleRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_ReapStaleRuns_Call) Return(_a0 []string, _a1 *contract.Error) *MockTrackingStore_ReapStaleRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_ReapStaleRuns_Call) RunAndReturn(run func(context.Context, time.Duration) ([]string, *contract.Error)) *MockTrackingStore_ReapStaleRuns_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LogMetric provides a mock function with given fields: ctx, runID, metric
-func (_m *MockTrackingStore) LogMetric(ctx context.Context, runID string, metric *entities.Metric) *contract.Error {
-	ret := _m.Called(ctx, runID, metric)
+// RecordRunHeartbeat provides a mock function with given fields: ctx, runID
+func (_m *MockTrackingStore) RecordRunHeartbeat(ctx context.Context, runID string) *contract.Error {
+	ret := _m.Called(ctx, runID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LogMetric")
+		panic("no return value specified for RecordRunHeartbeat")
 	}
 
 	var r0 *contract.Error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *entities.Metric) *contract.Error); ok {
-		r0 = rf(ctx, runID, metric)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *contract.Error); ok {
+		r0 = rf(ctx, runID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*contract.Error)
@@ -1142,47 +2085,46 @@ func (_m *MockTrackingStore) LogMetric(ctx context.Context, runID string, metric
 	return r0
 }
 
-// MockTrackingStore_LogMetric_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogMetric'
-type MockTrackingStore_LogMetric_Call struct {
+// MockTrackingStore_RecordRunHeartbeat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordRunHeartbeat'
+type MockTrackingStore_RecordRunHeartbeat_Call struct {
 	*mock.Call
 }
 
-// LogMetric is a helper method to define mock.On call
+// RecordRunHeartbeat is a helper method to define mock.On call
 //   - ctx context.Context
 //   - runID string
-//   - metric *entities.Metric
-func (_e *MockTrackingStore_Expecter) LogMetric(ctx interface{}, runID interface{}, metric interface{}) *MockTrackingStore_LogMetric_Call {
-	return &MockTrackingStore_LogMetric_Call{Call: _e.mock.On("LogMetric", ctx, runID, metric)}
+func (_e *MockTrackingStore_Expecter) RecordRunHeartbeat(ctx interface{}, runID interface{}) *MockTrackingStore_RecordRunHeartbeat_Call {
+	return &MockTrackingStore_RecordRunHeartbeat_Call{Call: _e.mock.On("RecordRunHeartbeat", ctx, runID)}
 }
 
-func (_c *MockTrackingStore_LogMetric_Call) Run(run func(ctx context.Context, runID string, metric *entities.Metric)) *MockTrackingStore_LogMetric_Call {
+func (_c *MockTrackingStore_RecordRunHeartbeat_Call) Run(run func(ctx context.Context, runID string)) *MockTrackingStore_RecordRunHeartbeat_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(*entities.Metric))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockTrackingStore_LogMetric_Call) Return(_a0 *contract.Error) *MockTrackingStore_LogMetric_Call {
+func (_c *MockTrackingStore_RecordRunHeartbeat_Call) Return(_a0 *contract.Error) *MockTrackingStore_RecordRunHeartbeat_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockTrackingStore_LogMetric_Call) RunAndReturn(run func(context.Context, string, *entities.Metric) *contract.Error) *MockTrackingStore_LogMetric_Call {
+func (_c *MockTrackingStore_RecordRunHeartbeat_Call) RunAndReturn(run func(context.Context, string) *contract.Error) *MockTrackingStore_RecordRunHeartbeat_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LogParam provides a mock function with given fields: ctx, runID, metric
-func (_m *MockTrackingStore) LogParam(ctx context.Context, runID string, metric *entities.Param) *contract.Error {
-	ret := _m.Called(ctx, runID, metric)
+// ReleaseIdempotencyKey provides a mock function with given fields: ctx, key
+func (_m *MockTrackingStore) ReleaseIdempotencyKey(ctx context.Context, key string) *contract.Error {
+	ret := _m.Called(ctx, key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LogParam")
+		panic("no return value specified for ReleaseIdempotencyKey")
 	}
 
 	var r0 *contract.Error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *entities.Param) *contract.Error); ok {
-		r0 = rf(ctx, runID, metric)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *contract.Error); ok {
+		r0 = rf(ctx, key)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*contract.Error)
@@ -1192,32 +2134,31 @@ func (_m *MockTrackingStore) LogParam(ctx context.Context, runID string, metric
 	return r0
 }
 
-// MockTrackingStore_LogParam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogParam'
-type MockTrackingStore_LogParam_Call struct {
+// MockTrackingStore_ReleaseIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseIdempotencyKey'
+type MockTrackingStore_ReleaseIdempotencyKey_Call struct {
 	*mock.Call
 }
 
-// LogParam is a helper method to define mock.On call
+// ReleaseIdempotencyKey is a helper method to define mock.On call
 //   - ctx context.Context
-//   - runID string
-//   - metric *entities.Param
-func (_e *MockTrackingStore_Expecter) LogParam(ctx interface{}, runID interface{}, metric interface{}) *MockTrackingStore_LogParam_Call {
-	return &MockTrackingStore_LogParam_Call{Call: _e.mock.On("LogParam", ctx, runID, metric)}
+//   - key string
+func (_e *MockTrackingStore_Expecter) ReleaseIdempotencyKey(ctx interface{}, key interface{}) *MockTrackingStore_ReleaseIdempotencyKey_Call {
+	return &MockTrackingStore_ReleaseIdempotencyKey_Call{Call: _e.mock.On("ReleaseIdempotencyKey", ctx, key)}
 }
 
-func (_c *MockTrackingStore_LogParam_Call) Run(run func(ctx context.Context, runID string, metric *entities.Param)) *MockTrackingStore_LogParam_Call {
+func (_c *MockTrackingStore_ReleaseIdempotencyKey_Call) Run(run func(ctx context.Context, key string)) *MockTrackingStore_ReleaseIdempotencyKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(*entities.Param))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockTrackingStore_LogParam_Call) Return(_a0 *contract.Error) *MockTrackingStore_LogParam_Call {
+func (_c *MockTrackingStore_ReleaseIdempotencyKey_Call) Return(_a0 *contract.Error) *MockTrackingStore_ReleaseIdempotencyKey_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockTrackingStore_LogParam_Call) RunAndReturn(run func(context.Context, string, *entities.Param) *contract.Error) *MockTrackingStore_LogParam_Call {
+func (_c *MockTrackingStore_ReleaseIdempotencyKey_Call) RunAndReturn(run func(context.Context, string) *contract.Error) *MockTrackingStore_ReleaseIdempotencyKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1370,6 +2311,57 @@ func (_c *MockTrackingStore_RestoreRun_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// SaveIdempotentResponse provides a mock function with given fields: ctx, key, status, body
+func (_m *MockTrackingStore) SaveIdempotentResponse(ctx context.Context, key string, status int, body []byte) *contract.Error {
+	ret := _m.Called(ctx, key, status, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveIdempotentResponse")
+	}
+
+	var r0 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, []byte) *contract.Error); ok {
+		r0 = rf(ctx, key, status, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contract.Error)
+		}
+	}
+
+	return r0
+}
+
+// MockTrackingStore_SaveIdempotentResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveIdempotentResponse'
+type MockTrackingStore_SaveIdempotentResponse_Call struct {
+	*mock.Call
+}
+
+// SaveIdempotentResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - status int
+//   - body []byte
+func (_e *MockTrackingStore_Expecter) SaveIdempotentResponse(ctx interface{}, key interface{}, status interface{}, body interface{}) *MockTrackingStore_SaveIdempotentResponse_Call {
+	return &MockTrackingStore_SaveIdempotentResponse_Call{Call: _e.mock.On("SaveIdempotentResponse", ctx, key, status, body)}
+}
+
+func (_c *MockTrackingStore_SaveIdempotentResponse_Call) Run(run func(ctx context.Context, key string, status int, body []byte)) *MockTrackingStore_SaveIdempotentResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_SaveIdempotentResponse_Call) Return(_a0 *contract.Error) *MockTrackingStore_SaveIdempotentResponse_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTrackingStore_SaveIdempotentResponse_Call) RunAndReturn(run func(context.Context, string, int, []byte) *contract.Error) *MockTrackingStore_SaveIdempotentResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchExperiments provides a mock function with given fields: ctx, experimentViewType, maxResults, filter, orderBy, pageToken
 func (_m *MockTrackingStore) SearchExperiments(ctx context.Context, experimentViewType protos.ViewType, maxResults int64, filter string, orderBy []string, pageToken string) ([]*entities.Experiment, string, *contract.Error) {
 	ret := _m.Called(ctx, experimentViewType, maxResults, filter, orderBy, pageToken)
@@ -1442,6 +2434,79 @@ func (_c *MockTrackingStore_SearchExperiments_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// SearchLoggedModels provides a mock function with given fields: ctx, experimentIDs, filter, datasets, maxResults, orderBy, pageToken
+func (_m *MockTrackingStore) SearchLoggedModels(ctx context.Context, experimentIDs []string, filter string, datasets []*entities.LoggedModelDataset, maxResults int, orderBy []*entities.LoggedModelOrderBy, pageToken string) ([]*entities.LoggedModel, string, *contract.Error) {
+	ret := _m.Called(ctx, experimentIDs, filter, datasets, maxResults, orderBy, pageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchLoggedModels")
+	}
+
+	var r0 []*entities.LoggedModel
+	var r1 string
+	var r2 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, []*entities.LoggedModelDataset, int, []*entities.LoggedModelOrderBy, string) ([]*entities.LoggedModel, string, *contract.Error)); ok {
+		return rf(ctx, experimentIDs, filter, datasets, maxResults, orderBy, pageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, []*entities.LoggedModelDataset, int, []*entities.LoggedModelOrderBy, string) []*entities.LoggedModel); ok {
+		r0 = rf(ctx, experimentIDs, filter, datasets, maxResults, orderBy, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.LoggedModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, []*entities.LoggedModelDataset, int, []*entities.LoggedModelOrderBy, string) string); ok {
+		r1 = rf(ctx, experimentIDs, filter, datasets, maxResults, orderBy, pageToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []string, string, []*entities.LoggedModelDataset, int, []*entities.LoggedModelOrderBy, string) *contract.Error); ok {
+		r2 = rf(ctx, experimentIDs, filter, datasets, maxResults, orderBy, pageToken)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*contract.Error)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// MockTrackingStore_SearchLoggedModels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchLoggedModels'
+type MockTrackingStore_SearchLoggedModels_Call struct {
+	*mock.Call
+}
+
+// SearchLoggedModels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - experimentIDs []string
+//   - filter string
+//   - datasets []*entities.LoggedModelDataset
+//   - maxResults int
+//   - orderBy []*entities.LoggedModelOrderBy
+//   - pageToken string
+func (_e *MockTrackingStore_Expecter) SearchLoggedModels(ctx interface{}, experimentIDs interface{}, filter interface{}, datasets interface{}, maxResults interface{}, orderBy interface{}, pageToken interface{}) *MockTrackingStore_SearchLoggedModels_Call {
+	return &MockTrackingStore_SearchLoggedModels_Call{Call: _e.mock.On("SearchLoggedModels", ctx, experimentIDs, filter, datasets, maxResults, orderBy, pageToken)}
+}
+
+func (_c *MockTrackingStore_SearchLoggedModels_Call) Run(run func(ctx context.Context, experimentIDs []string, filter string, datasets []*entities.LoggedModelDataset, maxResults int, orderBy []*entities.LoggedModelOrderBy, pageToken string)) *MockTrackingStore_SearchLoggedModels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string), args[3].([]*entities.LoggedModelDataset), args[4].(int), args[5].([]*entities.LoggedModelOrderBy), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_SearchLoggedModels_Call) Return(_a0 []*entities.LoggedModel, _a1 string, _a2 *contract.Error) *MockTrackingStore_SearchLoggedModels_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockTrackingStore_SearchLoggedModels_Call) RunAndReturn(run func(context.Context, []string, string, []*entities.LoggedModelDataset, int, []*entities.LoggedModelOrderBy, string) ([]*entities.LoggedModel, string, *contract.Error)) *MockTrackingStore_SearchLoggedModels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchRuns provides a mock function with given fields: ctx, experimentIDs, filter, runViewType, maxResults, orderBy, pageToken
 func (_m *MockTrackingStore) SearchRuns(ctx context.Context, experimentIDs []string, filter string, runViewType protos.ViewType, maxResults int, orderBy []string, pageToken string) ([]*entities.Run, string, *contract.Error) {
 	ret := _m.Called(ctx, experimentIDs, filter, runViewType, maxResults, orderBy, pageToken)
@@ -1515,6 +2580,151 @@ func (_c *MockTrackingStore_SearchRuns_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// SearchTraces provides a mock function with given fields: ctx, experimentIDs, filter, modelID, maxResults, orderBy, pageToken
+func (_m *MockTrackingStore) SearchTraces(ctx context.Context, experimentIDs []string, filter string, modelID string, maxResults int, orderBy []string, pageToken string) ([]*entities.TraceInfo, string, *contract.Error) {
+	ret := _m.Called(ctx, experimentIDs, filter, modelID, maxResults, orderBy, pageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTraces")
+	}
+
+	var r0 []*entities.TraceInfo
+	var r1 string
+	var r2 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, string, int, []string, string) ([]*entities.TraceInfo, string, *contract.Error)); ok {
+		return rf(ctx, experimentIDs, filter, modelID, maxResults, orderBy, pageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, string, int, []string, string) []*entities.TraceInfo); ok {
+		r0 = rf(ctx, experimentIDs, filter, modelID, maxResults, orderBy, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.TraceInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, string, int, []string, string) string); ok {
+		r1 = rf(ctx, experimentIDs, filter, modelID, maxResults, orderBy, pageToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []string, string, string, int, []string, string) *contract.Error); ok {
+		r2 = rf(ctx, experimentIDs, filter, modelID, maxResults, orderBy, pageToken)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*contract.Error)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// MockTrackingStore_SearchTraces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTraces'
+type MockTrackingStore_SearchTraces_Call struct {
+	*mock.Call
+}
+
+// SearchTraces is a helper method to define mock.On call
+//   - ctx context.Context
+//   - experimentIDs []string
+//   - filter string
+//   - modelID string
+//   - maxResults int
+//   - orderBy []string
+//   - pageToken string
+func (_e *MockTrackingStore_Expecter) SearchTraces(ctx interface{}, experimentIDs interface{}, filter interface{}, modelID interface{}, maxResults interface{}, orderBy interface{}, pageToken interface{}) *MockTrackingStore_SearchTraces_Call {
+	return &MockTrackingStore_SearchTraces_Call{Call: _e.mock.On("SearchTraces", ctx, experimentIDs, filter, modelID, maxResults, orderBy, pageToken)}
+}
+
+func (_c *MockTrackingStore_SearchTraces_Call) Run(run func(ctx context.Context, experimentIDs []string, filter string, modelID string, maxResults int, orderBy []string, pageToken string)) *MockTrackingStore_SearchTraces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string), args[3].(string), args[4].(int), args[5].([]string), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_SearchTraces_Call) Return(_a0 []*entities.TraceInfo, _a1 string, _a2 *contract.Error) *MockTrackingStore_SearchTraces_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockTrackingStore_SearchTraces_Call) RunAndReturn(run func(context.Context, []string, string, string, int, []string, string) ([]*entities.TraceInfo, string, *contract.Error)) *MockTrackingStore_SearchTraces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchTracesV3 provides a mock function with given fields: ctx, experimentIDs, filter, maxResults, orderBy, pageToken
+func (_m *MockTrackingStore) SearchTracesV3(ctx context.Context, experimentIDs []string, filter string, maxResults int, orderBy []string, pageToken string) ([]*entities.TraceInfoV3, string, *contract.Error) {
+	ret := _m.Called(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTracesV3")
+	}
+
+	var r0 []*entities.TraceInfoV3
+	var r1 string
+	var r2 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, int, []string, string) ([]*entities.TraceInfoV3, string, *contract.Error)); ok {
+		return rf(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, int, []string, string) []*entities.TraceInfoV3); ok {
+		r0 = rf(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.TraceInfoV3)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, int, []string, string) string); ok {
+		r1 = rf(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []string, string, int, []string, string) *contract.Error); ok {
+		r2 = rf(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*contract.Error)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// MockTrackingStore_SearchTracesV3_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTracesV3'
+type MockTrackingStore_SearchTracesV3_Call struct {
+	*mock.Call
+}
+
+// SearchTracesV3 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - experimentIDs []string
+//   - filter string
+//   - maxResults int
+//   - orderBy []string
+//   - pageToken string
+func (_e *MockTrackingStore_Expecter) SearchTracesV3(ctx interface{}, experimentIDs interface{}, filter interface{}, maxResults interface{}, orderBy interface{}, pageToken interface{}) *MockTrackingStore_SearchTracesV3_Call {
+	return &MockTrackingStore_SearchTracesV3_Call{Call: _e.mock.On("SearchTracesV3", ctx, experimentIDs, filter, maxResults, orderBy, pageToken)}
+}
+
+func (_c *MockTrackingStore_SearchTracesV3_Call) Run(run func(ctx context.Context, experimentIDs []string, filter string, maxResults int, orderBy []string, pageToken string)) *MockTrackingStore_SearchTracesV3_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string), args[3].(int), args[4].([]string), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_SearchTracesV3_Call) Return(_a0 []*entities.TraceInfoV3, _a1 string, _a2 *contract.Error) *MockTrackingStore_SearchTracesV3_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockTrackingStore_SearchTracesV3_Call) RunAndReturn(run func(context.Context, []string, string, int, []string, string) ([]*entities.TraceInfoV3, string, *contract.Error)) *MockTrackingStore_SearchTracesV3_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetExperimentTag provides a mock function with given fields: ctx, experimentID, key, value
 func (_m *MockTrackingStore) SetExperimentTag(ctx context.Context, experimentID string, key string, value string) *contract.Error {
 	ret := _m.Called(ctx, experimentID, key, value)
@@ -1566,6 +2776,56 @@ func (_c *MockTrackingStore_SetExperimentTag_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// SetLoggedModelTags provides a mock function with given fields: ctx, modelID, tags
+func (_m *MockTrackingStore) SetLoggedModelTags(ctx context.Context, modelID string, tags []*entities.LoggedModelTag) *contract.Error {
+	ret := _m.Called(ctx, modelID, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLoggedModelTags")
+	}
+
+	var r0 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*entities.LoggedModelTag) *contract.Error); ok {
+		r0 = rf(ctx, modelID, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contract.Error)
+		}
+	}
+
+	return r0
+}
+
+// MockTrackingStore_SetLoggedModelTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLoggedModelTags'
+type MockTrackingStore_SetLoggedModelTags_Call struct {
+	*mock.Call
+}
+
+// SetLoggedModelTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - modelID string
+//   - tags []*entities.LoggedModelTag
+func (_e *MockTrackingStore_Expecter) SetLoggedModelTags(ctx interface{}, modelID interface{}, tags interface{}) *MockTrackingStore_SetLoggedModelTags_Call {
+	return &MockTrackingStore_SetLoggedModelTags_Call{Call: _e.mock.On("SetLoggedModelTags", ctx, modelID, tags)}
+}
+
+func (_c *MockTrackingStore_SetLoggedModelTags_Call) Run(run func(ctx context.Context, modelID string, tags []*entities.LoggedModelTag)) *MockTrackingStore_SetLoggedModelTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]*entities.LoggedModelTag))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_SetLoggedModelTags_Call) Return(_a0 *contract.Error) *MockTrackingStore_SetLoggedModelTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTrackingStore_SetLoggedModelTags_Call) RunAndReturn(run func(context.Context, string, []*entities.LoggedModelTag) *contract.Error) *MockTrackingStore_SetLoggedModelTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetTag provides a mock function with given fields: ctx, runID, key, value
 func (_m *MockTrackingStore) SetTag(ctx context.Context, runID string, key string, value string) *contract.Error {
 	ret := _m.Called(ctx, runID, key, value)
@@ -94,7 +94,10 @@ func (s TrackingSQLStore) CreateExperiment(
 		}
 	}
 
-	if err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := s.transaction(ctx, func(transaction *gorm.DB) error {
+		// a retried transaction must not reuse what the rolled back one set.
+		experiment.ID, experiment.ArtifactLocation = 0, artifactLocation
+
 		if err := transaction.Create(&experiment).Error; err != nil {
 			return fmt.Errorf("failed to insert experiment: %w", err)
 		}
@@ -146,7 +149,7 @@ func (s TrackingSQLStore) DeleteExperiment(ctx context.Context, id string) *cont
 		return err
 	}
 
-	if err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		// Update experiment
 		uex := transaction.Model(&models.Experiment{}).
 			Where("experiment_id = ?", experimentID).
@@ -198,7 +201,7 @@ func (s TrackingSQLStore) RestoreExperiment(ctx context.Context, id string) *con
 		return err
 	}
 
-	if err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		// Update experiment
 		uex := transaction.Model(&models.Experiment{}).
 			Where("experiment_id = ?", experimentID).
@@ -271,40 +274,67 @@ func (s TrackingSQLStore) GetExperimentByName(
 	return experiment.ToEntity(), nil
 }
 
-func (s TrackingSQLStore) SearchExperiments(
+// searchExperimentsQuery returns the query of a page of SearchExperiments.
+func (s TrackingSQLStore) searchExperimentsQuery(
 	ctx context.Context,
 	experimentViewType protos.ViewType,
 	maxResults int64,
 	filter string,
 	orderBy []string,
 	pageToken string,
-) ([]*entities.Experiment, string, *contract.Error) {
-	query := applyExperimentsLifecycleStagesFilter(s.db.WithContext(ctx), experimentViewType)
+) (*experimentsQuery, *contract.Error) {
+	query := applyExperimentsLifecycleStagesFilter(s.reader(ctx), experimentViewType)
 
 	// apply Limit
 	query, limit := applyExperimentsLimitFilter(query, maxResults)
 
-	// apply Offset
-	query, offset, err := applyExperimentsOffsetFilter(query, pageToken)
+	token, err := decodePageToken(pageToken)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	// Apply Filter
-	query, err = applyExperimentsFilter(s.db, query, filter)
-	if err != nil {
-		return nil, "", err
+	if err := applyExperimentsFilter(ctx, s.db, query, filter); err != nil {
+		return nil, err
 	}
 
 	// OrderBy
-	query, err = applyExperimentsOrderBy(query, orderBy)
+	query, sortKeys, err := applyExperimentsOrderBy(query, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// apply PageToken
+	hash := searchHash(experimentViewType, filter, orderBy)
+	if err := applyPageToken(s.db, query, token, sortKeys, hash); err != nil {
+		return nil, err
+	}
+
+	return &experimentsQuery{
+		query:    query,
+		limit:    limit,
+		token:    token,
+		sortKeys: sortKeys,
+		hash:     hash,
+	}, nil
+}
+
+func (s TrackingSQLStore) SearchExperiments(
+	ctx context.Context,
+	experimentViewType protos.ViewType,
+	maxResults int64,
+	filter string,
+	orderBy []string,
+	pageToken string,
+) ([]*entities.Experiment, string, *contract.Error) {
+	search, err := s.searchExperimentsQuery(ctx, experimentViewType, maxResults, filter, orderBy, pageToken)
 	if err != nil {
 		return nil, "", err
 	}
 
 	// Actual query
 	var experiments []models.Experiment
-	if err := query.Preload("Tags").Find(&experiments).Error; err != nil {
+	if err := search.query.Preload("Tags").Find(&experiments).Error; err != nil {
 		return nil, "", contract.NewErrorWith(
 			protos.ErrorCode_INTERNAL_ERROR,
 			fmt.Sprintf("failed to get runs %q", err),
@@ -312,14 +342,31 @@ func (s TrackingSQLStore) SearchExperiments(
 		)
 	}
 
-	// encode `nextPageToken` value.
-	nextPageToken, err := createExperimentsNextPageToken(experiments, limit, offset)
-	if err != nil {
-		return nil, "", err
-	}
+	// encode `nextPageToken` value, the cursor of the last experiment of the page.
+	var nextPageToken string
+
+	if len(experiments) > search.limit {
+		experiments = experiments[:search.limit]
+
+		keys, err := sortValues(
+			search.query.Session(&gorm.Session{NewDB: true}).Model(&models.Experiment{}).Where(
+				"experiments.experiment_id = ?", experiments[search.limit-1].ID,
+			),
+			search.sortKeys,
+		)
+		if err != nil {
+			return nil, "", err
+		}
 
-	if len(experiments) > limit {
-		experiments = experiments[:limit]
+		//nolint:gosec // disable G115
+		nextPageToken, err = encodePageToken(&PageToken{
+			Offset: search.token.Offset + int32(search.limit),
+			Keys:   keys,
+			Hash:   search.hash,
+		})
+		if err != nil {
+			return nil, "", err
+		}
 	}
 
 	data := make([]*entities.Experiment, len(experiments))
@@ -347,7 +394,7 @@ func (s TrackingSQLStore) SetExperimentTag(
 		return err
 	}
 
-	if err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		experimentTag := models.ExperimentTag{
 			ExperimentID: idInt,
 			Key:          key,
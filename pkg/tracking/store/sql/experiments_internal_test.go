@@ -0,0 +1,108 @@
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+)
+
+var experimentsTests = []testData{
+	{
+		name:  "AttributeQuery",
+		query: "name ILIKE '%exp%' AND attributes.creation_time >= 1711089570679",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT * FROM "experiments"
+	WHERE experiments.name ILIKE $1 AND experiments.creation_time >= $2`,
+			"sqlite": `
+	SELECT * FROM experiments
+	WHERE LOWER(experiments.name) LIKE ? AND experiments.creation_time >= ?`,
+		},
+		expectedVars: []any{"%exp%", int64(1711089570679)},
+	},
+	{
+		name:  "TagQuery",
+		query: "tags.team = 'ml'",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "experiments"."experiment_id","experiments"."name","experiments"."artifact_location",
+	"experiments"."lifecycle_stage","experiments"."creation_time","experiments"."last_update_time"
+	FROM "experiments"
+	JOIN (SELECT "experiment_id","value" FROM "experiment_tags" WHERE key = $1 AND value = $2)
+	AS filter_0 ON experiments.experiment_id = filter_0.experiment_id`,
+		},
+		expectedVars: []any{"team", "ml"},
+	},
+	{
+		name:  "OrQuery",
+		query: "name = 'a' OR NOT tags.team = 'ml'",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT * FROM "experiments"
+	WHERE (experiments.name = $1 OR NOT (EXISTS (SELECT 1 FROM
+	(SELECT "experiment_id","value" FROM "experiment_tags" WHERE key = $2 AND value = $3)
+	AS filter_0_1 WHERE experiments.experiment_id = filter_0_1.experiment_id)))`,
+		},
+		expectedVars: []any{"a", "team", "ml"},
+	},
+}
+
+func TestSearchExperimentsFilter(t *testing.T) {
+	t.Parallel()
+
+	for _, newDialector := range []func() gorm.Dialector{
+		newPostgresDialector,
+		newSqliteDialector,
+	} {
+		database, err := gorm.Open(newDialector(), &gorm.Config{DryRun: true})
+		require.NoError(t, err)
+
+		dialectorName := database.Dialector.Name()
+
+		for _, testData := range experimentsTests {
+			expectedSQL, ok := testData.expectedSQL[dialectorName]
+			if !ok {
+				continue
+			}
+
+			t.Run(testData.name+"_"+dialectorName, func(t *testing.T) {
+				t.Parallel()
+
+				transaction := database.Model(&models.Experiment{})
+
+				contractErr := applyExperimentsFilter(context.Background(), database, transaction, testData.query)
+				require.Nil(t, contractErr)
+
+				require.NoError(t, transaction.Find(&[]models.Experiment{}).Error)
+
+				assert.Equal(t, removeWhitespace(expectedSQL), removeWhitespace(transaction.Statement.SQL.String()))
+				assert.Equal(t, testData.expectedVars, transaction.Statement.Vars)
+			})
+		}
+	}
+}
+
+func TestInvalidSearchExperimentsQuery(t *testing.T) {
+	t.Parallel()
+
+	database, err := gorm.Open(newSqliteDialector(), &gorm.Config{DryRun: true})
+	require.NoError(t, err)
+
+	for _, filter := range []string{
+		"metrics.accuracy > 0.5",
+		"creation_time = 'yesterday'",
+		"tags.team = 1",
+		"attribute.foo = 'bar'",
+		"name = 'a' AND",
+	} {
+		transaction := database.Model(&models.Experiment{})
+		if contractErr := applyExperimentsFilter(context.Background(), database, transaction, filter); contractErr == nil {
+			t.Errorf("expected contract error for %q", filter)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+)
+
+func (s TrackingSQLStore) ExplainSearchRuns(
+	ctx context.Context,
+	experimentIDs []string, filter string,
+	runViewType protos.ViewType, maxResults int, orderBy []string, pageToken string,
+) (*entities.SearchExplanation, *contract.Error) {
+	transaction, _, _, contractError := s.searchRunsQuery(
+		ctx, experimentIDs, filter, runViewType, maxResults, orderBy, pageToken,
+	)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	explanation, err := sql.Explain(transaction, &[]models.Run{})
+	if err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("failed to explain search runs: %v", err), err,
+		)
+	}
+
+	return explanation, nil
+}
+
+func (s TrackingSQLStore) ExplainSearchExperiments(
+	ctx context.Context,
+	experimentViewType protos.ViewType,
+	maxResults int64,
+	filter string,
+	orderBy []string,
+	pageToken string,
+) (*entities.SearchExplanation, *contract.Error) {
+	search, contractError := s.searchExperimentsQuery(ctx, experimentViewType, maxResults, filter, orderBy, pageToken)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	explanation, err := sql.Explain(search.query, &[]models.Experiment{})
+	if err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("failed to explain search experiments: %v", err), err,
+		)
+	}
+
+	return explanation, nil
+}
+
+func (s TrackingSQLStore) ExplainSearchTraces(
+	ctx context.Context,
+	experimentIDs []string,
+	filter string,
+	maxResults int,
+	orderBy []string,
+	pageToken string,
+) (*entities.SearchExplanation, *contract.Error) {
+	transaction, _, contractError := s.searchTracesQuery(ctx, experimentIDs, filter, "", maxResults, orderBy, pageToken)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	explanation, err := sql.Explain(transaction, &[]models.TraceInfo{})
+	if err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("failed to explain search traces: %v", err), err,
+		)
+	}
+
+	return explanation, nil
+}
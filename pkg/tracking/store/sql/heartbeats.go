@@ -0,0 +1,226 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+func (s TrackingSQLStore) RecordRunHeartbeat(ctx context.Context, runID string) *contract.Error {
+	err := s.transaction(ctx, func(transaction *gorm.DB) error {
+		var run models.Run
+		if err := transaction.Select(
+			"status", "lifecycle_stage",
+		).Where(
+			"run_uuid = ?", runID,
+		).First(&run).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return contract.NewError(
+					protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, fmt.Sprintf("Run with id=%s not found", runID),
+				)
+			}
+
+			return fmt.Errorf("failed to get run: %w", err)
+		}
+
+		if run.LifecycleStage != models.LifecycleStageActive || run.Status != models.RunStatusRunning {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_STATE,
+				fmt.Sprintf(
+					"The run %s must be an active %s run to record its heartbeat. Current status is %s.",
+					runID, models.RunStatusRunning, run.Status,
+				),
+			)
+		}
+
+		if err := transaction.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "run_uuid"}},
+			DoUpdates: clause.AssignmentColumns([]string{"last_heartbeat"}),
+		}).Create(&models.RunHeartbeat{
+			RunID:         runID,
+			LastHeartbeat: time.Now().UnixMilli(),
+		}).Error; err != nil {
+			return fmt.Errorf("failed to save heartbeat: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		var contractError *contract.Error
+		if errors.As(err, &contractError) {
+			return contractError
+		}
+
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("failed to record heartbeat of run %q", runID), err,
+		)
+	}
+
+	return nil
+}
+
+// runHeartbeatTimeouts returns the heartbeat timeouts the experiments set with a tag.
+func runHeartbeatTimeouts(ctx context.Context, transaction *gorm.DB) (map[int32]time.Duration, error) {
+	var tags []models.ExperimentTag
+	if err := transaction.Where("key = ?", utils.TagRunHeartbeatTimeout).Find(&tags).Error; err != nil {
+		return nil, fmt.Errorf("failed to get heartbeat timeouts of experiments: %w", err)
+	}
+
+	timeouts := make(map[int32]time.Duration, len(tags))
+
+	for _, tag := range tags {
+		timeout, err := time.ParseDuration(tag.Value)
+		if err != nil || timeout <= 0 {
+			utils.GetLoggerFromContext(ctx).Warnf(
+				"Ignoring invalid %s tag %q of experiment %d", utils.TagRunHeartbeatTimeout, tag.Value, tag.ExperimentID,
+			)
+
+			continue
+		}
+
+		timeouts[tag.ExperimentID] = timeout
+	}
+
+	return timeouts, nil
+}
+
+// staleRun is a running run which has not sent a heartbeat for the shortest of the timeouts.
+type staleRun struct {
+	RunID         string
+	ExperimentID  int32
+	LastHeartbeat int64
+}
+
+// reaperLockName is the lock of the server reaping the stale runs, the others sharing its database
+// leaving them to it.
+const reaperLockName = "mlflow_go_run_reaper"
+
+//nolint:funlen,cyclop,gocognit
+func (s TrackingSQLStore) ReapStaleRuns(ctx context.Context, defaultTimeout time.Duration) ([]string, *contract.Error) {
+	now := time.Now()
+
+	var reaped []string
+
+	err := s.transaction(ctx, func(transaction *gorm.DB) (err error) {
+		reaped = nil
+
+		locked, err := sql.TryLock(transaction, reaperLockName)
+		if err != nil || !locked {
+			return err
+		}
+
+		defer func() {
+			err = errors.Join(err, sql.Unlock(transaction, reaperLockName))
+		}()
+
+		// the heartbeats of the runs which ended, or were garbage collected, are no longer needed.
+		if err := transaction.Where(
+			"NOT EXISTS (?)",
+			transaction.Model(&models.Run{}).Select("1").Where(
+				"runs.run_uuid = run_heartbeats.run_uuid AND runs.status = ?", models.RunStatusRunning,
+			),
+		).Delete(&models.RunHeartbeat{}).Error; err != nil {
+			return fmt.Errorf("failed to delete heartbeats of ended runs: %w", err)
+		}
+
+		timeouts, err := runHeartbeatTimeouts(ctx, transaction)
+		if err != nil {
+			return err
+		}
+
+		shortestTimeout := defaultTimeout
+		for _, timeout := range timeouts {
+			shortestTimeout = min(shortestTimeout, timeout)
+		}
+
+		var staleRuns []staleRun
+		if err := transaction.Model(
+			&models.RunHeartbeat{},
+		).Select(
+			"runs.run_uuid AS run_id, runs.experiment_id, run_heartbeats.last_heartbeat",
+		).Joins(
+			"JOIN runs ON runs.run_uuid = run_heartbeats.run_uuid",
+		).Where(
+			"runs.status = ? AND runs.lifecycle_stage = ?", models.RunStatusRunning, models.LifecycleStageActive,
+		).Where(
+			"run_heartbeats.last_heartbeat < ?", now.Add(-shortestTimeout).UnixMilli(),
+		).Scan(&staleRuns).Error; err != nil {
+			return fmt.Errorf("failed to get stale runs: %w", err)
+		}
+
+		tags := make([]models.Tag, 0, len(staleRuns))
+
+		for _, run := range staleRuns {
+			timeout, ok := timeouts[run.ExperimentID]
+			if !ok {
+				timeout = defaultTimeout
+			}
+
+			deadline := now.Add(-timeout).UnixMilli()
+			if run.LastHeartbeat >= deadline {
+				continue
+			}
+
+			// a heartbeat, or an update of the run, may have come in since the stale runs were read.
+			result := transaction.Model(&models.Run{}).Where(
+				"run_uuid = ? AND status = ?", run.RunID, models.RunStatusRunning,
+			).Where(
+				"run_uuid IN (?)",
+				transaction.Model(&models.RunHeartbeat{}).Select("run_uuid").Where(
+					"run_uuid = ? AND last_heartbeat < ?", run.RunID, deadline,
+				),
+			).Updates(map[string]any{
+				"status":   models.RunStatusFailed,
+				"end_time": now.UnixMilli(),
+			})
+			if result.Error != nil {
+				return fmt.Errorf("failed to fail stale run %q: %w", run.RunID, result.Error)
+			}
+
+			if result.RowsAffected == 0 {
+				continue
+			}
+
+			reaped = append(reaped, run.RunID)
+			tags = append(tags, models.Tag{
+				RunID: run.RunID,
+				Key:   utils.TagStaleRunReason,
+				Value: fmt.Sprintf(
+					"No heartbeat received for %s, the last one at %s",
+					timeout, time.UnixMilli(run.LastHeartbeat).UTC().Format(time.RFC3339),
+				),
+			})
+		}
+
+		if len(reaped) == 0 {
+			return nil
+		}
+
+		if err := transaction.Clauses(clause.OnConflict{
+			UpdateAll: true,
+		}).CreateInBatches(tags, tagsBatchSize).Error; err != nil {
+			return fmt.Errorf("failed to tag stale runs: %w", err)
+		}
+
+		if err := transaction.Where("run_uuid IN ?", reaped).Delete(&models.RunHeartbeat{}).Error; err != nil {
+			return fmt.Errorf("failed to delete heartbeats of stale runs: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to reap stale runs", err)
+	}
+
+	return reaped, nil
+}
@@ -0,0 +1,119 @@
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+func TestReapStaleRuns(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newSqliteStore(t)
+
+	experimentID, contractErr := store.CreateExperiment(ctx, "experiment", "/tmp", nil)
+	require.Nil(t, contractErr)
+
+	patientExperimentID, contractErr := store.CreateExperiment(ctx, "patient", "/tmp", []*entities.ExperimentTag{
+		{Key: utils.TagRunHeartbeatTimeout, Value: "1h"},
+	})
+	require.Nil(t, contractErr)
+
+	runIDs := make([]string, 0, 3)
+
+	for _, experimentID := range []string{experimentID, patientExperimentID, experimentID} {
+		run, contractErr := store.CreateRun(ctx, experimentID, "user", 0, nil, "run")
+		require.Nil(t, contractErr)
+
+		runIDs = append(runIDs, run.Info.RunID)
+	}
+
+	contractErr = store.RecordRunHeartbeat(ctx, "missing")
+	require.NotNil(t, contractErr)
+	assert.Equal(t, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, protos.ErrorCode(contractErr.Code))
+
+	// the last run never sends a heartbeat, so it is left alone.
+	for _, runID := range runIDs[:2] {
+		require.Nil(t, store.RecordRunHeartbeat(ctx, runID))
+	}
+
+	require.NoError(t, store.db.Model(&models.RunHeartbeat{}).Where("1 = 1").Update(
+		"last_heartbeat", time.Now().Add(-2*time.Minute).UnixMilli(),
+	).Error)
+
+	reaped, contractErr := store.ReapStaleRuns(ctx, time.Minute)
+	require.Nil(t, contractErr)
+	assert.Equal(t, runIDs[:1], reaped)
+
+	for i, status := range []string{"FAILED", "RUNNING", "RUNNING"} {
+		run, contractErr := store.GetRun(ctx, runIDs[i])
+		require.Nil(t, contractErr)
+		assert.Equal(t, status, run.Info.Status)
+
+		reason, contractErr := store.GetRunTag(ctx, runIDs[i], utils.TagStaleRunReason)
+		require.Nil(t, contractErr)
+
+		if i == 0 {
+			assert.NotNil(t, run.Info.EndTime)
+			require.NotNil(t, reason)
+			assert.Contains(t, reason.Value, "No heartbeat received for 1m0s")
+		} else {
+			assert.Nil(t, run.Info.EndTime)
+			assert.Nil(t, reason)
+		}
+	}
+
+	// the failed run can no longer send heartbeats.
+	contractErr = store.RecordRunHeartbeat(ctx, runIDs[0])
+	require.NotNil(t, contractErr)
+	assert.Equal(t, protos.ErrorCode_INVALID_STATE, protos.ErrorCode(contractErr.Code))
+
+	reaped, contractErr = store.ReapStaleRuns(ctx, time.Minute)
+	require.Nil(t, contractErr)
+	assert.Empty(t, reaped)
+}
+
+func TestReapStaleRunsOnlyOnce(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newPostgresStore(t)
+
+	experimentID, contractErr := store.CreateExperiment(ctx, "experiment", "/tmp", nil)
+	require.Nil(t, contractErr)
+
+	run, contractErr := store.CreateRun(ctx, experimentID, "user", 0, nil, "run")
+	require.Nil(t, contractErr)
+	require.Nil(t, store.RecordRunHeartbeat(ctx, run.Info.RunID))
+	require.NoError(t, store.db.Model(&models.RunHeartbeat{}).Where("1 = 1").Update(
+		"last_heartbeat", time.Now().Add(-2*time.Minute).UnixMilli(),
+	).Error)
+
+	// another server is reaping, so the stale run is left to it.
+	require.NoError(t, store.db.Transaction(func(transaction *gorm.DB) error {
+		locked, err := sql.TryLock(transaction, reaperLockName)
+		require.NoError(t, err)
+		require.True(t, locked)
+
+		reaped, contractErr := store.ReapStaleRuns(ctx, time.Minute)
+		require.Nil(t, contractErr)
+		assert.Empty(t, reaped)
+
+		return nil
+	}))
+
+	reaped, contractErr := store.ReapStaleRuns(ctx, time.Minute)
+	require.Nil(t, contractErr)
+	assert.Equal(t, []string{run.Info.RunID}, reaped)
+}
@@ -0,0 +1,123 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+)
+
+// idempotencyClaimTimeout is the write timeout of the server, after which the request which claimed
+// a key without recording its response can no longer be answered, so the key is claimed again.
+const idempotencyClaimTimeout = 10 * time.Minute
+
+// expiredIdempotencyKeys scopes transaction to the keys older than ttl, and to the claims of the
+// requests which can no longer be answered.
+func expiredIdempotencyKeys(transaction *gorm.DB, now time.Time, ttl time.Duration) *gorm.DB {
+	return transaction.Where(
+		"creation_time < ? OR (response_status = 0 AND creation_time < ?)",
+		now.Add(-ttl).UnixMilli(), now.Add(-idempotencyClaimTimeout).UnixMilli(),
+	)
+}
+
+func (s TrackingSQLStore) ClaimIdempotencyKey(
+	ctx context.Context, key, requestHash string, ttl time.Duration,
+) (*entities.IdempotentResponse, *contract.Error) {
+	now := time.Now()
+
+	var claimed *models.IdempotencyKey
+
+	err := s.transaction(ctx, func(transaction *gorm.DB) error {
+		claimed = nil
+
+		// the expired keys are deleted by the reaper, but the key may expire in between.
+		if err := expiredIdempotencyKeys(transaction, now, ttl).Where(
+			"idempotency_key = ?", key,
+		).Delete(&models.IdempotencyKey{}).Error; err != nil {
+			return fmt.Errorf("failed to delete expired idempotency key: %w", err)
+		}
+
+		result := transaction.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.IdempotencyKey{
+			Key:          key,
+			RequestHash:  requestHash,
+			CreationTime: now.UnixMilli(),
+		})
+		if result.Error != nil {
+			return fmt.Errorf("failed to claim idempotency key: %w", result.Error)
+		}
+
+		if result.RowsAffected == 1 {
+			return nil
+		}
+
+		claimed = &models.IdempotencyKey{}
+		if err := transaction.Where("idempotency_key = ?", key).First(claimed).Error; err != nil {
+			return fmt.Errorf("failed to get idempotency key: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("failed to claim idempotency key %q", key), err,
+		)
+	}
+
+	// the key is claimed by this request, so there is no response yet.
+	if claimed == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	return claimed.ToEntity(), nil
+}
+
+func (s TrackingSQLStore) SaveIdempotentResponse(
+	ctx context.Context, key string, status int, body []byte,
+) *contract.Error {
+	if err := s.db.WithContext(ctx).Model(
+		&models.IdempotencyKey{},
+	).Where(
+		"idempotency_key = ?", key,
+	).Updates(map[string]any{
+		"response_status": status,
+		"response_body":   string(body),
+	}).Error; err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("failed to save response of idempotency key %q", key), err,
+		)
+	}
+
+	return nil
+}
+
+func (s TrackingSQLStore) ReleaseIdempotencyKey(ctx context.Context, key string) *contract.Error {
+	if err := s.db.WithContext(ctx).Where(
+		"idempotency_key = ? AND response_status = 0", key,
+	).Delete(&models.IdempotencyKey{}).Error; err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("failed to release idempotency key %q", key), err,
+		)
+	}
+
+	return nil
+}
+
+func (s TrackingSQLStore) DeleteExpiredIdempotencyKeys(
+	ctx context.Context, ttl time.Duration,
+) (int64, *contract.Error) {
+	result := expiredIdempotencyKeys(s.db.WithContext(ctx), time.Now(), ttl).Delete(&models.IdempotencyKey{})
+	if result.Error != nil {
+		return 0, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "failed to delete expired idempotency keys", result.Error,
+		)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,67 @@
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newSqliteStore(t)
+
+	response, err := store.ClaimIdempotencyKey(ctx, "key", "hash", time.Hour)
+	require.Nil(t, err)
+	assert.Nil(t, response)
+
+	// the key is claimed until the response of its request is saved.
+	response, err = store.ClaimIdempotencyKey(ctx, "key", "other-hash", time.Hour)
+	require.Nil(t, err)
+	require.NotNil(t, response)
+	assert.Equal(t, "hash", response.RequestHash)
+	assert.Equal(t, 0, response.Status)
+
+	require.Nil(t, store.SaveIdempotentResponse(ctx, "key", 200, []byte(`{"run_id":"1"}`)))
+	require.Nil(t, store.ReleaseIdempotencyKey(ctx, "key"))
+
+	response, err = store.ClaimIdempotencyKey(ctx, "key", "hash", time.Hour)
+	require.Nil(t, err)
+	require.NotNil(t, response)
+	assert.Equal(t, 200, response.Status)
+	assert.Equal(t, `{"run_id":"1"}`, string(response.Body))
+
+	// a released claim, and an expired key, are claimed again.
+	response, err = store.ClaimIdempotencyKey(ctx, "released", "hash", time.Hour)
+	require.Nil(t, err)
+	require.Nil(t, response)
+	require.Nil(t, store.ReleaseIdempotencyKey(ctx, "released"))
+
+	response, err = store.ClaimIdempotencyKey(ctx, "released", "hash", time.Hour)
+	require.Nil(t, err)
+	assert.Nil(t, response)
+
+	time.Sleep(2 * time.Millisecond)
+
+	response, err = store.ClaimIdempotencyKey(ctx, "key", "hash", time.Millisecond)
+	require.Nil(t, err)
+	assert.Nil(t, response)
+
+	// the reaper deletes the expired keys, leaving the others.
+	_, err = store.ClaimIdempotencyKey(ctx, "expired", "hash", time.Hour)
+	require.Nil(t, err)
+
+	time.Sleep(2 * time.Millisecond)
+
+	deleted, err := store.DeleteExpiredIdempotencyKeys(ctx, time.Millisecond)
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), deleted)
+
+	deleted, err = store.DeleteExpiredIdempotencyKeys(ctx, time.Hour)
+	require.Nil(t, err)
+	assert.Equal(t, int64(0), deleted)
+}
@@ -151,7 +151,7 @@ func mkDataset(
 func (s TrackingSQLStore) LogInputs(
 	ctx context.Context, runID string, modelInputs []*entities.ModelInput, datasets []*entities.DatasetInput,
 ) *contract.Error {
-	err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		contractError := checkRunIsActive(transaction, runID)
 		if contractError != nil {
 			return contractError
@@ -0,0 +1,397 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+const (
+	LoggedModelIDPrefix   = "m-"
+	ModelsFolderName      = "models"
+	loggedModelsBatchSize = 100
+)
+
+func newNullString(value *string) sql.NullString {
+	if value == nil {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: *value, Valid: true}
+}
+
+// getActiveLoggedModel loads a logged model with its tags, params and metrics.
+// Deleted models are reported as missing.
+func getActiveLoggedModel(transaction *gorm.DB, modelID string) (*models.LoggedModel, *contract.Error) {
+	var loggedModel models.LoggedModel
+	if err := transaction.Preload(
+		"Tags",
+	).Preload(
+		"Params",
+	).Preload(
+		"Metrics",
+	).Where(
+		"model_id = ?", modelID,
+	).Where(
+		"lifecycle_stage != ?", models.LifecycleStageDeleted,
+	).First(&loggedModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("Logged model with ID '%s' not found.", modelID),
+			)
+		}
+
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to get logged model %q", modelID),
+			err,
+		)
+	}
+
+	return &loggedModel, nil
+}
+
+//nolint:funlen
+func (s TrackingSQLStore) CreateLoggedModel(
+	ctx context.Context,
+	experimentID, name string,
+	modelType, sourceRunID *string,
+	params []*entities.LoggedModelParam,
+	tags []*entities.LoggedModelTag,
+) (*entities.LoggedModel, *contract.Error) {
+	experiment, err := s.GetExperiment(ctx, experimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkExperimentIsActive(experiment); err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		randomName, err := utils.GenerateRandomName()
+		if err != nil {
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR, "failed to generate random logged model name", err,
+			)
+		}
+
+		name = randomName
+	}
+
+	modelID := LoggedModelIDPrefix + utils.NewUUID()
+
+	artifactLocation, appendErr := utils.AppendToURIPath(
+		experiment.ArtifactLocation,
+		ModelsFolderName,
+		modelID,
+		ArtifactFolderName,
+	)
+	if appendErr != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"failed to append model ID to experiment artifact location",
+			appendErr,
+		)
+	}
+
+	creationTime := time.Now().UnixMilli()
+	loggedModel := models.LoggedModel{
+		ID:                     modelID,
+		ExperimentID:           utils.ConvertStringPointerToInt32Pointer(&experimentID),
+		Name:                   name,
+		ArtifactLocation:       artifactLocation,
+		CreationTimestampMS:    creationTime,
+		LastUpdatedTimestampMS: creationTime,
+		Status:                 models.LoggedModelStatus(protos.LoggedModelStatus_LOGGED_MODEL_PENDING),
+		LifecycleStage:         models.LifecycleStageActive,
+		ModelType:              newNullString(modelType),
+		SourceRunID:            newNullString(sourceRunID),
+		Tags:                   make([]models.LoggedModelTag, 0, len(tags)),
+		Params:                 make([]models.LoggedModelParam, 0, len(params)),
+	}
+
+	for _, tag := range tags {
+		loggedModel.Tags = append(
+			loggedModel.Tags, models.NewLoggedModelTagFromEntity(modelID, loggedModel.ExperimentID, tag),
+		)
+	}
+
+	for _, param := range params {
+		loggedModel.Params = append(
+			loggedModel.Params, models.NewLoggedModelParamFromEntity(modelID, loggedModel.ExperimentID, param),
+		)
+	}
+
+	if err := s.db.WithContext(ctx).Create(&loggedModel).Error; err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to create logged model for experiment_id %q", experimentID),
+			err,
+		)
+	}
+
+	return loggedModel.ToEntity(), nil
+}
+
+func (s TrackingSQLStore) GetLoggedModel(
+	ctx context.Context, modelID string,
+) (*entities.LoggedModel, *contract.Error) {
+	loggedModel, err := getActiveLoggedModel(s.db.WithContext(ctx), modelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return loggedModel.ToEntity(), nil
+}
+
+func (s TrackingSQLStore) FinalizeLoggedModel(
+	ctx context.Context, modelID, status string,
+) (*entities.LoggedModel, *contract.Error) {
+	var finalizedModel *entities.LoggedModel
+
+	if err := s.withActiveLoggedModel(ctx, modelID, func(transaction *gorm.DB, loggedModel *models.LoggedModel) error {
+		loggedModel.Status = models.NewLoggedModelStatus(status)
+		loggedModel.LastUpdatedTimestampMS = time.Now().UnixMilli()
+
+		if err := transaction.Model(&models.LoggedModel{}).Where("model_id = ?", modelID).UpdateColumns(map[string]any{
+			"status":                    loggedModel.Status,
+			"last_updated_timestamp_ms": loggedModel.LastUpdatedTimestampMS,
+		}).Error; err != nil {
+			return contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to finalize logged model %q", modelID),
+				err,
+			)
+		}
+
+		finalizedModel = loggedModel.ToEntity()
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return finalizedModel, nil
+}
+
+func (s TrackingSQLStore) DeleteLoggedModel(ctx context.Context, modelID string) *contract.Error {
+	return s.withActiveLoggedModel(ctx, modelID, func(transaction *gorm.DB, loggedModel *models.LoggedModel) error {
+		if err := transaction.Model(&models.LoggedModel{}).Where("model_id = ?", modelID).UpdateColumns(map[string]any{
+			"lifecycle_stage":           models.LifecycleStageDeleted,
+			"last_updated_timestamp_ms": time.Now().UnixMilli(),
+		}).Error; err != nil {
+			return contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to delete logged model %q", modelID),
+				err,
+			)
+		}
+
+		return nil
+	})
+}
+
+func (s TrackingSQLStore) SetLoggedModelTags(
+	ctx context.Context, modelID string, tags []*entities.LoggedModelTag,
+) *contract.Error {
+	return s.withActiveLoggedModel(ctx, modelID, func(transaction *gorm.DB, loggedModel *models.LoggedModel) error {
+		loggedModelTags := make([]models.LoggedModelTag, 0, len(tags))
+		for _, tag := range tags {
+			loggedModelTags = append(
+				loggedModelTags, models.NewLoggedModelTagFromEntity(modelID, loggedModel.ExperimentID, tag),
+			)
+		}
+
+		if err := transaction.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "model_id"}, {Name: "tag_key"}},
+			DoUpdates: clause.AssignmentColumns([]string{"tag_value"}),
+		}).CreateInBatches(loggedModelTags, loggedModelsBatchSize).Error; err != nil {
+			return contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to set tags for logged model %q", modelID),
+				err,
+			)
+		}
+
+		return nil
+	})
+}
+
+func (s TrackingSQLStore) DeleteLoggedModelTag(ctx context.Context, modelID, key string) *contract.Error {
+	return s.withActiveLoggedModel(ctx, modelID, func(transaction *gorm.DB, _ *models.LoggedModel) error {
+		result := transaction.Where(
+			"model_id = ?", modelID,
+		).Where(
+			"tag_key = ?", key,
+		).Delete(&models.LoggedModelTag{})
+		if result.Error != nil {
+			return contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to delete tag %q for logged model %q", key, modelID),
+				result.Error,
+			)
+		}
+
+		if result.RowsAffected == 0 {
+			return contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("No tag with key '%s' found for model with ID '%s'.", key, modelID),
+			)
+		}
+
+		return nil
+	})
+}
+
+func (s TrackingSQLStore) LogLoggedModelParams(
+	ctx context.Context, modelID string, params []*entities.LoggedModelParam,
+) *contract.Error {
+	return s.withActiveLoggedModel(ctx, modelID, func(transaction *gorm.DB, loggedModel *models.LoggedModel) error {
+		existingParams := make(map[string]string, len(loggedModel.Params))
+		for _, param := range loggedModel.Params {
+			existingParams[param.Key] = param.Value
+		}
+
+		loggedModelParams := make([]models.LoggedModelParam, 0, len(params))
+
+		for _, param := range params {
+			value, ok := existingParams[param.Key]
+
+			switch {
+			case ok && value != param.Value:
+				return contract.NewError(
+					protos.ErrorCode_INVALID_PARAMETER_VALUE,
+					fmt.Sprintf(
+						"Changing param values is not allowed. Param with key=%q was already logged "+
+							"with value=%q for model ID=%q. Attempted logging new value %q",
+						param.Key, value, modelID, param.Value,
+					),
+				)
+			case !ok:
+				existingParams[param.Key] = param.Value
+				loggedModelParams = append(
+					loggedModelParams, models.NewLoggedModelParamFromEntity(modelID, loggedModel.ExperimentID, param),
+				)
+			}
+		}
+
+		if err := transaction.CreateInBatches(loggedModelParams, loggedModelsBatchSize).Error; err != nil {
+			return contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to log params for logged model %q", modelID),
+				err,
+			)
+		}
+
+		return nil
+	})
+}
+
+func (s TrackingSQLStore) withActiveLoggedModel(
+	ctx context.Context,
+	modelID string,
+	callback func(transaction *gorm.DB, loggedModel *models.LoggedModel) error,
+) *contract.Error {
+	if err := s.transaction(ctx, func(transaction *gorm.DB) error {
+		loggedModel, contractError := getActiveLoggedModel(transaction, modelID)
+		if contractError != nil {
+			return contractError
+		}
+
+		return callback(transaction, loggedModel)
+	}); err != nil {
+		var contractError *contract.Error
+		if errors.As(err, &contractError) {
+			return contractError
+		}
+
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to update logged model %q", modelID),
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (s TrackingSQLStore) SearchLoggedModels(
+	ctx context.Context,
+	experimentIDs []string,
+	filter string,
+	datasets []*entities.LoggedModelDataset,
+	maxResults int,
+	orderBy []*entities.LoggedModelOrderBy,
+	pageToken string,
+) ([]*entities.LoggedModel, string, *contract.Error) {
+	transaction := s.db.WithContext(ctx).Model(
+		&models.LoggedModel{},
+	).Where(
+		"logged_models.experiment_id IN ?", experimentIDs,
+	).Where(
+		"logged_models.lifecycle_stage != ?", models.LifecycleStageDeleted,
+	)
+
+	// MaxResults
+	transaction.Limit(maxResults)
+
+	// PageToken
+	offset, contractError := getOffset(pageToken)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	transaction.Offset(offset)
+
+	// Filter
+	if contractError := applyLoggedModelsFilter(ctx, s.db, transaction, filter, datasets); contractError != nil {
+		return nil, "", contractError
+	}
+
+	// OrderBy
+	if contractError := applyLoggedModelsOrderBy(s.db, transaction, orderBy); contractError != nil {
+		return nil, "", contractError
+	}
+
+	var loggedModels []models.LoggedModel
+	if err := transaction.Preload(
+		"Tags",
+	).Preload(
+		"Params",
+	).Preload(
+		"Metrics",
+	).Find(
+		&loggedModels,
+	).Error; err != nil {
+		return nil, "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"failed to query search logged models",
+			err,
+		)
+	}
+
+	entityModels := make([]*entities.LoggedModel, 0, len(loggedModels))
+	for _, loggedModel := range loggedModels {
+		entityModels = append(entityModels, loggedModel.ToEntity())
+	}
+
+	nextPageToken, contractError := mkNextPageToken(len(loggedModels), maxResults, offset)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	return entityModels, nextPageToken, nil
+}
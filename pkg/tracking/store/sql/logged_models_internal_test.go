@@ -0,0 +1,166 @@
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+)
+
+type loggedModelsTestData struct {
+	name         string
+	query        string
+	datasets     []*entities.LoggedModelDataset
+	orderBy      []*entities.LoggedModelOrderBy
+	expectedSQL  map[string]string
+	expectedVars []any
+}
+
+var loggedModelsTests = []loggedModelsTestData{
+	{
+		name:  "AttributeQuery",
+		query: "status = 'READY' AND creation_time > 1711089570679",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT logged_models.* FROM "logged_models"
+	WHERE logged_models.status = $1 AND logged_models.creation_timestamp_ms > $2
+	ORDER BY logged_models.creation_timestamp_ms DESC,logged_models.model_id`,
+		},
+		expectedVars: []any{models.LoggedModelStatus(2), float64(1711089570679)},
+	},
+	{
+		name:  "ParamAndTagQuery",
+		query: "params.alpha = '0.5' AND tags.env ILIKE 'prod%'",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT logged_models.* FROM "logged_models"
+	JOIN (SELECT "model_id" FROM "logged_model_params" WHERE param_key = $1 AND param_value = $2)
+	AS filter_0 ON logged_models.model_id = filter_0.model_id
+	JOIN (SELECT "model_id" FROM "logged_model_tags" WHERE tag_key = $3 AND tag_value ILIKE $4)
+	AS filter_1 ON logged_models.model_id = filter_1.model_id
+	ORDER BY logged_models.creation_timestamp_ms DESC,logged_models.model_id`,
+			"sqlite": `
+	SELECT logged_models.* FROM logged_models
+	JOIN (SELECT model_id FROM logged_model_params WHERE param_key = ? AND param_value = ?)
+	AS filter_0 ON logged_models.model_id = filter_0.model_id
+	JOIN (SELECT model_id FROM logged_model_tags WHERE tag_key = ? AND LOWER(tag_value) LIKE ?)
+	AS filter_1 ON logged_models.model_id = filter_1.model_id
+	ORDER BY logged_models.creation_timestamp_ms DESC,logged_models.model_id`,
+		},
+		expectedVars: []any{"alpha", "0.5", "env", "prod%"},
+	},
+	{
+		name:     "MetricQueryScopedToDataset",
+		query:    "metrics.accuracy > 0.9",
+		datasets: []*entities.LoggedModelDataset{{Name: "test", Digest: "abc"}},
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT logged_models.* FROM "logged_models"
+	JOIN (SELECT model_id,metric_value FROM (SELECT model_id, metric_value,
+	ROW_NUMBER() OVER (PARTITION BY model_id ORDER BY metric_timestamp_ms DESC, metric_step DESC) AS metric_rank
+	FROM "logged_model_metrics" WHERE metric_name = $1 AND (dataset_name = $2 AND dataset_digest = $3))
+	AS ranked_metrics WHERE metric_rank = 1 AND metric_value > $4)
+	AS filter_0 ON logged_models.model_id = filter_0.model_id
+	ORDER BY logged_models.creation_timestamp_ms DESC,logged_models.model_id`,
+		},
+		expectedVars: []any{"accuracy", "test", "abc", float64(0.9)},
+	},
+	{
+		name: "OrderByMetric",
+		orderBy: []*entities.LoggedModelOrderBy{
+			{FieldName: "metrics.accuracy", Ascending: false},
+			{FieldName: "name", Ascending: true},
+		},
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT logged_models.*,
+	(CASE WHEN (order_0.metric_value IS NULL) THEN 1 ELSE 0 END) AS order_null_0,
+	(CASE WHEN (logged_models.name IS NULL) THEN 1 ELSE 0 END) AS order_null_1
+	FROM "logged_models"
+	LEFT OUTER JOIN (SELECT model_id,metric_value FROM (SELECT model_id, metric_value,
+	ROW_NUMBER() OVER (PARTITION BY model_id ORDER BY metric_timestamp_ms DESC, metric_step DESC) AS metric_rank
+	FROM "logged_model_metrics" WHERE metric_name = $1)
+	AS ranked_metrics WHERE metric_rank = 1)
+	AS order_0 ON logged_models.model_id = order_0.model_id
+	ORDER BY order_null_0,"order_0"."metric_value" DESC,order_null_1,"logged_models"."name",
+	logged_models.creation_timestamp_ms DESC,logged_models.model_id`,
+		},
+		expectedVars: []any{"accuracy"},
+	},
+}
+
+func TestSearchLoggedModels(t *testing.T) {
+	t.Parallel()
+
+	for _, newDialector := range []func() gorm.Dialector{
+		newPostgresDialector,
+		newSqliteDialector,
+	} {
+		database, err := gorm.Open(newDialector(), &gorm.Config{DryRun: true})
+		require.NoError(t, err)
+
+		dialectorName := database.Dialector.Name()
+
+		for _, testData := range loggedModelsTests {
+			expectedSQL, ok := testData.expectedSQL[dialectorName]
+			if !ok {
+				continue
+			}
+
+			t.Run(testData.name+"_"+dialectorName, func(t *testing.T) {
+				t.Parallel()
+
+				transaction := database.Model(&models.LoggedModel{})
+
+				contractErr := applyLoggedModelsFilter(
+					context.Background(), database, transaction, testData.query, testData.datasets,
+				)
+				require.Nil(t, contractErr)
+
+				contractErr = applyLoggedModelsOrderBy(database, transaction, testData.orderBy)
+				require.Nil(t, contractErr)
+
+				require.NoError(t, transaction.Find(&[]models.LoggedModel{}).Error)
+
+				assert.Equal(t, removeWhitespace(expectedSQL), removeWhitespace(transaction.Statement.SQL.String()))
+				assert.Equal(t, testData.expectedVars, transaction.Statement.Vars)
+			})
+		}
+	}
+}
+
+func TestInvalidSearchLoggedModels(t *testing.T) {
+	t.Parallel()
+
+	database, err := gorm.Open(newSqliteDialector(), &gorm.Config{DryRun: true})
+	require.NoError(t, err)
+
+	for _, filter := range []string{
+		"metrics.accuracy > '0.9'",
+		"params.alpha = 0.5",
+		"run.status = 'READY'",
+		"status = 'DONE'",
+		"foo = 'bar'",
+	} {
+		transaction := database.Model(&models.LoggedModel{})
+		if contractErr := applyLoggedModelsFilter(
+			context.Background(), database, transaction, filter, nil,
+		); contractErr == nil {
+			t.Errorf("expected contract error for %q", filter)
+		}
+	}
+
+	for _, fieldName := range []string{"params.alpha", "foo"} {
+		transaction := database.Model(&models.LoggedModel{})
+		if contractErr := applyLoggedModelsOrderBy(
+			database, transaction, []*entities.LoggedModelOrderBy{{FieldName: fieldName}},
+		); contractErr == nil {
+			t.Errorf("expected contract error for order by %q", fieldName)
+		}
+	}
+}
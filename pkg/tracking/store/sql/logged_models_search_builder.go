@@ -0,0 +1,248 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+)
+
+// applyDatasetsCondition restricts logged model metrics to the given datasets.
+// A dataset without a digest matches every digest of that dataset name.
+func applyDatasetsCondition(database, transaction *gorm.DB, datasets []*entities.LoggedModelDataset) *gorm.DB {
+	if len(datasets) == 0 {
+		return transaction
+	}
+
+	condition := database
+	for _, dataset := range datasets {
+		datasetCondition := database.Where("dataset_name = ?", dataset.Name)
+		if dataset.Digest != "" {
+			datasetCondition = datasetCondition.Where("dataset_digest = ?", dataset.Digest)
+		}
+
+		condition = condition.Or(datasetCondition)
+	}
+
+	return transaction.Where(condition)
+}
+
+// latestLoggedModelMetrics selects, for every logged model, the most recent value of the metric key.
+func latestLoggedModelMetrics(
+	database *gorm.DB, key string, datasets []*entities.LoggedModelDataset,
+) *gorm.DB {
+	rankedMetrics := database.Model(
+		&models.LoggedModelMetric{},
+	).Select(
+		"model_id, metric_value, "+
+			"ROW_NUMBER() OVER (PARTITION BY model_id ORDER BY metric_timestamp_ms DESC, metric_step DESC) AS metric_rank",
+	).Where(
+		"metric_name = ?", key,
+	)
+
+	rankedMetrics = applyDatasetsCondition(database, rankedMetrics, datasets)
+
+	return database.Table(
+		"(?) AS ranked_metrics", rankedMetrics,
+	).Select(
+		"model_id", "metric_value",
+	).Where(
+		"metric_rank = 1",
+	)
+}
+
+// loggedModelStatusValue converts a status name, or a list of them, to the integer stored in the database.
+func loggedModelStatusValue(value any) (any, *contract.Error) {
+	convert := func(status string) (models.LoggedModelStatus, *contract.Error) {
+		converted, ok := models.ParseLoggedModelStatus(status)
+		if !ok {
+			return 0, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid logged model status: %q", status),
+			)
+		}
+
+		return converted, nil
+	}
+
+	switch typedValue := value.(type) {
+	case string:
+		return convert(typedValue)
+	case []string:
+		statuses := make([]models.LoggedModelStatus, 0, len(typedValue))
+
+		for _, status := range typedValue {
+			converted, err := convert(status)
+			if err != nil {
+				return nil, err
+			}
+
+			statuses = append(statuses, converted)
+		}
+
+		return statuses, nil
+	default:
+		return value, nil
+	}
+}
+
+// loggedModelsFilterTranslator translates the comparisons of a logged models filter, the
+// metrics being restricted to datasets. The subqueries select the matching models in model_id.
+type loggedModelsFilterTranslator struct {
+	datasets []*entities.LoggedModelDataset
+}
+
+func (t loggedModelsFilterTranslator) TranslateFilter(
+	database *gorm.DB, clause *parser.ValidCompareExpr,
+) (*sql.Filter, *contract.Error) {
+	key := clause.Key
+
+	//nolint:exhaustive
+	switch clause.Identifier {
+	case parser.Metric:
+		return &sql.Filter{
+			Subquery: latestLoggedModelMetrics(database, key, t.datasets).Where(
+				sql.ComparisonCondition(database, "metric_value", clause),
+			),
+		}, nil
+	case parser.Parameter:
+		return &sql.Filter{
+			Subquery: database.Select("model_id").Where(
+				"param_key = ?", key,
+			).Where(
+				sql.ComparisonCondition(database, "param_value", clause),
+			).Model(&models.LoggedModelParam{}),
+		}, nil
+	case parser.Tag:
+		return &sql.Filter{
+			Subquery: database.Select("model_id").Where(
+				"tag_key = ?", key,
+			).Where(
+				sql.ComparisonCondition(database, "tag_value", clause),
+			).Model(&models.LoggedModelTag{}),
+		}, nil
+	default:
+		where, value := sql.ComparisonCondition(database, "logged_models."+key, clause)
+
+		if key == parser.LoggedModelStatus {
+			var contractError *contract.Error
+			if value, contractError = loggedModelStatusValue(value); contractError != nil {
+				return nil, contractError
+			}
+		}
+
+		return &sql.Filter{Where: where, Value: value}, nil
+	}
+}
+
+func applyLoggedModelsFilter(
+	ctx context.Context,
+	database, transaction *gorm.DB,
+	filter string,
+	datasets []*entities.LoggedModelDataset,
+) *contract.Error {
+	return sql.ApplyFilter(ctx, database, transaction, &sql.FilterEntity{
+		Table:      "logged_models",
+		Keys:       []string{"model_id"},
+		Schema:     parser.LoggedModelSchema,
+		Translator: loggedModelsFilterTranslator{datasets: datasets},
+	}, filter)
+}
+
+//nolint:funlen
+func applyLoggedModelsOrderBy(
+	database, transaction *gorm.DB, orderBy []*entities.LoggedModelOrderBy,
+) *contract.Error {
+	columnSelection := "logged_models.*"
+
+	for index, orderByClause := range orderBy {
+		var column string
+
+		identifier, key, isQualified := strings.Cut(orderByClause.FieldName, ".")
+
+		switch {
+		case !isQualified:
+			if orderByClause.Dataset != nil {
+				return contract.NewError(
+					protos.ErrorCode_INVALID_PARAMETER_VALUE,
+					fmt.Sprintf(
+						"dataset_name and dataset_digest may only be set when ordering by a metric, got %q",
+						orderByClause.FieldName,
+					),
+				)
+			}
+
+			attributeKey, err := parser.ParseLoggedModelAttributeKey(orderByClause.FieldName)
+			if err != nil {
+				return contract.NewError(
+					protos.ErrorCode_INVALID_PARAMETER_VALUE,
+					fmt.Sprintf("Invalid order by field name: %q", orderByClause.FieldName),
+				)
+			}
+
+			column = "logged_models." + attributeKey
+		case identifier == "metrics" || identifier == "metric":
+			var datasets []*entities.LoggedModelDataset
+
+			if orderByClause.Dataset != nil {
+				if orderByClause.Dataset.Name == "" {
+					return contract.NewError(
+						protos.ErrorCode_INVALID_PARAMETER_VALUE,
+						"dataset_digest may only be set if dataset_name is also set",
+					)
+				}
+
+				datasets = append(datasets, orderByClause.Dataset)
+			}
+
+			table := fmt.Sprintf("order_%d", index)
+
+			transaction.Joins(
+				fmt.Sprintf("LEFT OUTER JOIN (?) AS %s ON logged_models.model_id = %s.model_id", table, table),
+				latestLoggedModelMetrics(database, key, datasets),
+			)
+
+			column = table + ".metric_value"
+		default:
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"Invalid order by field name: %q. Only metrics and attributes are supported.",
+					orderByClause.FieldName,
+				),
+			)
+		}
+
+		// models without the metric are always placed last.
+		nullableColumnAlias := fmt.Sprintf("order_null_%d", index)
+		columnSelection = fmt.Sprintf(
+			"%s, (CASE WHEN (%s IS NULL) THEN 1 ELSE 0 END) AS %s",
+			columnSelection,
+			column,
+			nullableColumnAlias,
+		)
+
+		transaction.Order(nullableColumnAlias)
+		transaction.Order(clause.OrderByColumn{
+			Column: clause.Column{
+				Name: column,
+			},
+			Desc: !orderByClause.Ascending,
+		})
+	}
+
+	transaction.Order("logged_models.creation_timestamp_ms DESC")
+	transaction.Order("logged_models.model_id")
+	transaction.Select(columnSelection)
+
+	return nil
+}
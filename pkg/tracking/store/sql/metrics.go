@@ -1,10 +1,13 @@
 package sql
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
+	"slices"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -12,6 +15,7 @@ import (
 	"github.com/mlflow/mlflow-go-backend/pkg/contract"
 	"github.com/mlflow/mlflow-go-backend/pkg/entities"
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/sql"
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
 )
 
@@ -71,7 +75,6 @@ func isNewerMetric(a models.Metric, b models.LatestMetric) bool {
 		(a.Step == b.Step && a.Timestamp == b.Timestamp && a.Value > b.Value)
 }
 
-//nolint:cyclop
 func updateLatestMetricsIfNecessary(transaction *gorm.DB, runID string, metrics []models.Metric) error {
 	if len(metrics) == 0 {
 		return nil
@@ -92,19 +95,13 @@ func updateLatestMetricsIfNecessary(transaction *gorm.DB, runID string, metrics
 	nextLatestMetricsMap := make(map[string]models.LatestMetric, len(metrics))
 
 	for _, metric := range metrics {
-		latestMetric, found := latestMetricsMap[metric.Key]
-		nextLatestMetric, alreadyPresent := nextLatestMetricsMap[metric.Key]
+		// compare with the newest metric of the batch so far, else with the row in the database
+		latestMetric, found := nextLatestMetricsMap[metric.Key]
+		if !found {
+			latestMetric, found = latestMetricsMap[metric.Key]
+		}
 
-		switch {
-		case !found && !alreadyPresent:
-			// brand new latest metric
-			nextLatestMetricsMap[metric.Key] = metric.NewLatestMetricFromProto()
-		case !found && alreadyPresent && isNewerMetric(metric, nextLatestMetric):
-			// there is no row in the database but the metric is present twice
-			// and we need to take the latest one from the batch.
-			nextLatestMetricsMap[metric.Key] = metric.NewLatestMetricFromProto()
-		case found && isNewerMetric(metric, latestMetric):
-			// compare with the row in the database
+		if !found || isNewerMetric(metric, latestMetric) {
 			nextLatestMetricsMap[metric.Key] = metric.NewLatestMetricFromProto()
 		}
 	}
@@ -125,6 +122,58 @@ func updateLatestMetricsIfNecessary(transaction *gorm.DB, runID string, metrics
 	return nil
 }
 
+// mergeCopiedMetrics inserts the metrics copied into metrics_batch, and updates the latest metrics
+// when the newest of the batch is newer, in the order of isNewerMetric.
+const mergeCopiedMetrics = `
+WITH inserted AS (
+	INSERT INTO metrics (key, value, timestamp, step, is_nan, run_uuid)
+	SELECT key, value, timestamp, step, is_nan, ? FROM metrics_batch
+	ON CONFLICT DO NOTHING
+)
+INSERT INTO latest_metrics AS latest (key, value, timestamp, step, is_nan, run_uuid)
+SELECT DISTINCT ON (key) key, value, timestamp, step, is_nan, ? FROM metrics_batch
+ORDER BY key, step DESC, timestamp DESC, value DESC, position
+ON CONFLICT (key, run_uuid) DO UPDATE SET
+	value = excluded.value, timestamp = excluded.timestamp, step = excluded.step, is_nan = excluded.is_nan
+WHERE (excluded.step, excluded.timestamp, excluded.value) > (latest.step, latest.timestamp, latest.value)`
+
+// logMetricsWithCopy is the PostgreSQL fast path of logging metrics, which streams them with COPY
+// into a temporary table, merged into metrics and latest_metrics in a single statement. Creating the
+// temporary table only pays off for the batches inserted in more than one statement otherwise, see
+// metricsBatchSize.
+func logMetricsWithCopy(transaction *gorm.DB, runID string, metrics []models.Metric) error {
+	if len(metrics) == 0 {
+		return nil
+	}
+
+	if err := transaction.Exec(`
+		CREATE TEMPORARY TABLE metrics_batch (
+			key VARCHAR(250), value DOUBLE PRECISION, timestamp BIGINT, step BIGINT, is_nan BOOLEAN,
+			position INTEGER
+		) ON COMMIT DROP`,
+	).Error; err != nil {
+		return fmt.Errorf("failed to create metrics_batch: %w", err)
+	}
+
+	// position breaks the ties of isNewerMetric in favor of the first metric of the batch.
+	rows := make([][]any, 0, len(metrics))
+	for position, metric := range metrics {
+		rows = append(rows, []any{metric.Key, metric.Value, metric.Timestamp, metric.Step, metric.IsNaN, position})
+	}
+
+	if err := sql.CopyFrom(
+		transaction, "metrics_batch", []string{"key", "value", "timestamp", "step", "is_nan", "position"}, rows,
+	); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if err := transaction.Exec(mergeCopiedMetrics, runID, runID).Error; err != nil {
+		return fmt.Errorf("failed to merge metrics_batch: %w", err)
+	}
+
+	return nil
+}
+
 func (s TrackingSQLStore) logMetricsWithTransaction(
 	transaction *gorm.DB, runID string, metrics []*entities.Metric,
 ) *contract.Error {
@@ -141,6 +190,18 @@ func (s TrackingSQLStore) logMetricsWithTransaction(
 		}
 	}
 
+	if sql.CanCopy(transaction) && len(modelMetrics) > metricsBatchSize {
+		if err := logMetricsWithCopy(transaction, runID, modelMetrics); err != nil {
+			return contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("error copying metrics for run_uuid %q", runID),
+				err,
+			)
+		}
+
+		return nil
+	}
+
 	if err := transaction.Clauses(clause.OnConflict{DoNothing: true}).
 		CreateInBatches(modelMetrics, metricsBatchSize).Error; err != nil {
 		return contract.NewErrorWith(
@@ -193,7 +254,7 @@ func (s TrackingSQLStore) logModelMetricsWithTransaction(
 }
 
 func (s TrackingSQLStore) LogMetric(ctx context.Context, runID string, metric *entities.Metric) *contract.Error {
-	err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		contractError := checkRunIsActive(transaction, runID)
 		if contractError != nil {
 			return contractError
@@ -232,9 +293,7 @@ func (s TrackingSQLStore) LogMetric(ctx context.Context, runID string, metric *e
 func (s TrackingSQLStore) GetMetricHistory(
 	ctx context.Context, runID, metricKey, pageToken string, maxResults *int32,
 ) ([]*entities.Metric, string, *contract.Error) {
-	query := s.db.WithContext(
-		ctx,
-	).Where(
+	query := s.reader(ctx).Where(
 		"run_uuid = ?", runID,
 	).Where(
 		"key = ?", metricKey,
@@ -278,3 +337,130 @@ func (s TrackingSQLStore) GetMetricHistory(
 
 	return entityMetrics, nextPageToken, nil
 }
+
+type metricStepRange struct {
+	RunID   string `gorm:"column:run_uuid"`
+	MinStep int64  `gorm:"column:min_step"`
+	MaxStep int64  `gorm:"column:max_step"`
+}
+
+// sampleSteps evenly picks maxResults steps out of the sorted steps,
+// always keeping the last one so the end of the interval is represented.
+func sampleSteps(steps []int64, maxResults int) []int64 {
+	if len(steps) <= maxResults {
+		return steps
+	}
+
+	interval := float64(len(steps)) / float64(maxResults)
+	sampled := make([]int64, 0, maxResults+1)
+
+	for i := range maxResults {
+		sampled = append(sampled, steps[int(float64(i)*interval)])
+	}
+
+	return append(sampled, steps[len(steps)-1])
+}
+
+//nolint:funlen,cyclop
+func (s TrackingSQLStore) GetMetricHistoryBulkInterval(
+	ctx context.Context, runIDs []string, metricKey string, startStep, endStep *int64, maxResults int,
+) ([]*entities.MetricWithRunID, *contract.Error) {
+	var stepRanges []metricStepRange
+	if err := s.db.WithContext(ctx).Model(
+		&models.Metric{},
+	).Select(
+		"run_uuid, MIN(step) AS min_step, MAX(step) AS max_step",
+	).Where(
+		"run_uuid IN ?", runIDs,
+	).Where(
+		"key = ?", metricKey,
+	).Group("run_uuid").Scan(&stepRanges).Error; err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "error getting metric step ranges", err,
+		)
+	}
+
+	if len(stepRanges) == 0 {
+		return make([]*entities.MetricWithRunID, 0), nil
+	}
+
+	// without an explicit interval, sample over every step logged for any of the runs.
+	var start, end int64
+	if startStep != nil && endStep != nil {
+		start, end = *startStep, *endStep
+	} else {
+		for _, stepRange := range stepRanges {
+			end = max(end, stepRange.MaxStep)
+		}
+	}
+
+	var steps []int64
+	if err := s.db.WithContext(ctx).Model(
+		&models.Metric{},
+	).Distinct("step").Where(
+		"run_uuid IN ?", runIDs,
+	).Where(
+		"key = ?", metricKey,
+	).Where(
+		"step BETWEEN ? AND ?", start, end,
+	).Order("step").Pluck("step", &steps).Error; err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "error getting metric steps", err,
+		)
+	}
+
+	selectedSteps := make(map[int64]struct{}, maxResults)
+	for _, step := range sampleSteps(steps, maxResults) {
+		selectedSteps[step] = struct{}{}
+	}
+
+	// the first and last step of every run are always returned, so each line has its full extent.
+	for _, stepRange := range stepRanges {
+		for _, step := range []int64{stepRange.MinStep, stepRange.MaxStep} {
+			if step >= start && step <= end {
+				selectedSteps[step] = struct{}{}
+			}
+		}
+	}
+
+	sortedSteps := slices.Sorted(maps.Keys(selectedSteps))
+	metrics := make([]models.Metric, 0, len(sortedSteps)*len(runIDs))
+
+	for skip := 0; skip < len(sortedSteps); skip += metricsBatchSize {
+		batch := sortedSteps[skip:min(skip+metricsBatchSize, len(sortedSteps))]
+
+		var currentBatch []models.Metric
+		if err := s.db.WithContext(ctx).Where(
+			"run_uuid IN ?", runIDs,
+		).Where(
+			"key = ?", metricKey,
+		).Where(
+			"step IN ?", batch,
+		).Find(&currentBatch).Error; err != nil {
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR, "error getting metric history", err,
+			)
+		}
+
+		metrics = append(metrics, currentBatch...)
+	}
+
+	slices.SortFunc(metrics, func(a, b models.Metric) int {
+		return cmp.Or(
+			cmp.Compare(a.RunID, b.RunID),
+			cmp.Compare(a.Step, b.Step),
+			cmp.Compare(a.Timestamp, b.Timestamp),
+			cmp.Compare(a.Value, b.Value),
+		)
+	})
+
+	entityMetrics := make([]*entities.MetricWithRunID, len(metrics))
+	for i, metric := range metrics {
+		entityMetrics[i] = &entities.MetricWithRunID{
+			Metric: *metric.ToEntity(),
+			RunID:  metric.RunID,
+		}
+	}
+
+	return entityMetrics, nil
+}
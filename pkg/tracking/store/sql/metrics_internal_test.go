@@ -0,0 +1,120 @@
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+)
+
+func TestSampleSteps(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		steps      []int64
+		maxResults int
+		expected   []int64
+	}{
+		{
+			name:       "FewerStepsThanMaxResults",
+			steps:      []int64{0, 1, 2},
+			maxResults: 5,
+			expected:   []int64{0, 1, 2},
+		},
+		{
+			name:       "EvenlySampled",
+			steps:      []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			maxResults: 5,
+			expected:   []int64{0, 2, 4, 6, 8, 9},
+		},
+		{
+			name:       "UnevenInterval",
+			steps:      []int64{0, 10, 20, 30, 40, 50, 60},
+			maxResults: 3,
+			expected:   []int64{0, 20, 40, 60},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, sampleSteps(test.steps, test.maxResults))
+		})
+	}
+}
+
+// TestLogBatchKeepsNewestLatestMetric logs batches larger than metricsBatchSize, which are copied on
+// PostgreSQL, and checks the latest metrics are the newest by step, then timestamp, then value.
+func TestLogBatchKeepsNewestLatestMetric(t *testing.T) {
+	t.Parallel()
+
+	for name, newStore := range map[string]func(t *testing.T) *TrackingSQLStore{
+		"SQLite":     newSqliteStore,
+		"PostgreSQL": newPostgresStore,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			store := newStore(t)
+
+			experimentID, contractErr := store.CreateExperiment(ctx, "experiment", "/tmp", nil)
+			require.Nil(t, contractErr)
+
+			run, contractErr := store.CreateRun(ctx, experimentID, "user", 0, nil, "run")
+			require.Nil(t, contractErr)
+
+			for index, metrics := range [][]*entities.Metric{
+				{
+					{Key: "out_of_order", Value: 1, Step: 1},
+					{Key: "value_tie", Value: 1, Step: 2, Timestamp: 10},
+					{Key: "timestamp_tie", Value: 1, Step: 2, Timestamp: 20},
+				},
+				{
+					{Key: "out_of_order", Value: 3, Step: 5},
+					{Key: "out_of_order", Value: 2, Step: 3},
+					{Key: "out_of_order", Value: 0, Step: 5},
+					{Key: "value_tie", Value: 3, Step: 2, Timestamp: 10},
+					{Key: "value_tie", Value: 2, Step: 2, Timestamp: 10},
+					{Key: "timestamp_tie", Value: 5, Step: 2, Timestamp: 10},
+				},
+				{
+					{Key: "out_of_order", Value: 4, Step: 4},
+					{Key: "value_tie", Value: 3, Step: 2, Timestamp: 10},
+					{Key: "value_tie", Value: -1, Step: 2, Timestamp: 10},
+				},
+			} {
+				for step := range int64(metricsBatchSize) {
+					metrics = append(metrics, &entities.Metric{Key: "padding", Step: step, Timestamp: int64(index)})
+				}
+
+				require.Nil(t, store.LogBatch(ctx, run.Info.RunID, metrics, nil, nil))
+			}
+
+			run, contractErr = store.GetRun(ctx, run.Info.RunID)
+			require.Nil(t, contractErr)
+
+			latestMetrics := make(map[string]*entities.Metric, len(run.Data.Metrics))
+			for _, metric := range run.Data.Metrics {
+				latestMetrics[metric.Key] = metric
+			}
+
+			for key, expected := range map[string]*entities.Metric{
+				"out_of_order":  {Value: 3, Step: 5},
+				"value_tie":     {Value: 3, Step: 2, Timestamp: 10},
+				"timestamp_tie": {Value: 1, Step: 2, Timestamp: 20},
+				"padding":       {Step: metricsBatchSize - 1, Timestamp: 2},
+			} {
+				require.Contains(t, latestMetrics, key)
+				assert.Equal(t, expected.Step, latestMetrics[key].Step, key)
+				assert.Equal(t, expected.Timestamp, latestMetrics[key].Timestamp, key)
+				assert.InDelta(t, expected.Value, latestMetrics[key].Value, 0, key)
+			}
+		})
+	}
+}
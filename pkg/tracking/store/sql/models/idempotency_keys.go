@@ -0,0 +1,22 @@
+package models
+
+import (
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+)
+
+// IdempotencyKey mapped from table <idempotency_keys>.
+type IdempotencyKey struct {
+	Key            string `gorm:"column:idempotency_key;primaryKey"`
+	RequestHash    string `gorm:"column:request_hash"`
+	ResponseStatus int    `gorm:"column:response_status"`
+	ResponseBody   string `gorm:"column:response_body"`
+	CreationTime   int64  `gorm:"column:creation_time"`
+}
+
+func (k IdempotencyKey) ToEntity() *entities.IdempotentResponse {
+	return &entities.IdempotentResponse{
+		RequestHash: k.RequestHash,
+		Status:      k.ResponseStatus,
+		Body:        []byte(k.ResponseBody),
+	}
+}
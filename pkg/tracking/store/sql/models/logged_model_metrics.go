@@ -49,3 +49,18 @@ func NewLoggedMetricFromEntity(runID string, metric *entities.Metric) *LoggedMod
 
 	return &model
 }
+
+func (m LoggedModelMetric) ToEntity() *entities.MetricWithRunID {
+	return &entities.MetricWithRunID{
+		Metric: entities.Metric{
+			Key:           m.MetricName,
+			Value:         m.MetricValue,
+			Timestamp:     m.MetricTimestampMs,
+			Step:          m.MetricStep,
+			ModelID:       m.ModelID,
+			DatasetName:   m.DatasetName,
+			DatasetDigest: m.DatasetDigest,
+		},
+		RunID: m.RunID,
+	}
+}
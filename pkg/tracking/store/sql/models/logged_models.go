@@ -0,0 +1,97 @@
+package models
+
+import (
+	"database/sql"
+	"strconv"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// LoggedModelStatus is stored as the integer value of protos.LoggedModelStatus.
+type LoggedModelStatus int32
+
+func (s LoggedModelStatus) String() string {
+	return protos.LoggedModelStatus(s).String()
+}
+
+func NewLoggedModelStatus(status string) LoggedModelStatus {
+	return LoggedModelStatus(protos.LoggedModelStatus_value[status])
+}
+
+// ParseLoggedModelStatus accepts both the proto names, e.g. LOGGED_MODEL_READY,
+// and the short names used by the Python client, e.g. READY.
+func ParseLoggedModelStatus(status string) (LoggedModelStatus, bool) {
+	switch status {
+	case "UNSPECIFIED":
+		status = protos.LoggedModelStatus_LOGGED_MODEL_STATUS_UNSPECIFIED.String()
+	case "PENDING", "READY", "UPLOAD_FAILED":
+		status = "LOGGED_MODEL_" + status
+	case "FAILED":
+		status = protos.LoggedModelStatus_LOGGED_MODEL_UPLOAD_FAILED.String()
+	}
+
+	value, ok := protos.LoggedModelStatus_value[status]
+
+	return LoggedModelStatus(value), ok
+}
+
+// LoggedModel mapped from table <logged_models>.
+type LoggedModel struct {
+	ID                     string              `gorm:"column:model_id;primaryKey"`
+	ExperimentID           int32               `gorm:"column:experiment_id"`
+	Name                   string              `gorm:"column:name"`
+	ArtifactLocation       string              `gorm:"column:artifact_location"`
+	CreationTimestampMS    int64               `gorm:"column:creation_timestamp_ms"`
+	LastUpdatedTimestampMS int64               `gorm:"column:last_updated_timestamp_ms"`
+	Status                 LoggedModelStatus   `gorm:"column:status"`
+	LifecycleStage         LifecycleStage      `gorm:"column:lifecycle_stage"`
+	ModelType              sql.NullString      `gorm:"column:model_type"`
+	SourceRunID            sql.NullString      `gorm:"column:source_run_id"`
+	StatusMessage          sql.NullString      `gorm:"column:status_message"`
+	Tags                   []LoggedModelTag    `gorm:"foreignKey:ModelID"`
+	Params                 []LoggedModelParam  `gorm:"foreignKey:ModelID"`
+	Metrics                []LoggedModelMetric `gorm:"foreignKey:ModelID"`
+}
+
+func (m LoggedModel) ToEntity() *entities.LoggedModel {
+	loggedModel := entities.LoggedModel{
+		ModelID:                m.ID,
+		ExperimentID:           strconv.Itoa(int(m.ExperimentID)),
+		Name:                   m.Name,
+		ArtifactURI:            m.ArtifactLocation,
+		CreationTimestampMS:    m.CreationTimestampMS,
+		LastUpdatedTimestampMS: m.LastUpdatedTimestampMS,
+		Status:                 m.Status.String(),
+		Tags:                   make([]*entities.LoggedModelTag, 0, len(m.Tags)),
+		Params:                 make([]*entities.LoggedModelParam, 0, len(m.Params)),
+		Metrics:                make([]*entities.MetricWithRunID, 0, len(m.Metrics)),
+	}
+
+	if m.ModelType.Valid {
+		loggedModel.ModelType = utils.PtrTo(m.ModelType.String)
+	}
+
+	if m.SourceRunID.Valid {
+		loggedModel.SourceRunID = utils.PtrTo(m.SourceRunID.String)
+	}
+
+	if m.StatusMessage.Valid {
+		loggedModel.StatusMessage = utils.PtrTo(m.StatusMessage.String)
+	}
+
+	for _, tag := range m.Tags {
+		loggedModel.Tags = append(loggedModel.Tags, tag.ToEntity())
+	}
+
+	for _, param := range m.Params {
+		loggedModel.Params = append(loggedModel.Params, param.ToEntity())
+	}
+
+	for _, metric := range m.Metrics {
+		loggedModel.Metrics = append(loggedModel.Metrics, metric.ToEntity())
+	}
+
+	return &loggedModel
+}
@@ -0,0 +1,7 @@
+package models
+
+// RunHeartbeat mapped from table <run_heartbeats>.
+type RunHeartbeat struct {
+	RunID         string `gorm:"column:run_uuid;primaryKey"`
+	LastHeartbeat int64  `gorm:"column:last_heartbeat"`
+}
@@ -20,7 +20,7 @@ type TraceInfo struct {
 	ExperimentID         string                 `gorm:"column:experiment_id"`
 	ClientRequestID      sql.NullString         `gorm:"column:client_request_id"`
 	RequestPreview       sql.NullString         `gorm:"column:request_preview"`
-	ResponsePreview      sql.NullString         `gorm:"column:request_preview"`
+	ResponsePreview      sql.NullString         `gorm:"column:response_preview"`
 	TimestampMS          int64                  `gorm:"column:timestamp_ms"`
 	ExecutionTimeMS      sql.NullInt64          `gorm:"column:execution_time_ms"`
 	Status               string                 `gorm:"column:status"`
@@ -0,0 +1,229 @@
+package sql
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// PageToken is the decoded page_token of the searches.
+//
+// SearchRuns and SearchExperiments page with a cursor: Keys are the values the last result of
+// the previous page is sorted by, its id being the last of them, and the next page starts
+// right after it. Hash identifies the search the token was issued for. Tokens without Keys,
+// like the ones of the other searches or of older servers, page with Offset, which cursor
+// tokens also carry for the servers which don't know about Keys.
+type PageToken struct {
+	Offset int32  `json:"offset"`
+	Keys   []any  `json:"keys,omitempty"`
+	Hash   string `json:"hash,omitempty"`
+}
+
+func decodePageToken(pageToken string) (*PageToken, *contract.Error) {
+	var token PageToken
+
+	if pageToken == "" {
+		return &token, nil
+	}
+
+	decoder := json.NewDecoder(
+		base64.NewDecoder(
+			base64.StdEncoding,
+			strings.NewReader(pageToken),
+		),
+	)
+	// numbers are kept exact, see sortValue.
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&token); err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Invalid page token: %q", pageToken),
+			err,
+		)
+	}
+
+	for index, key := range token.Keys {
+		token.Keys[index] = sortValue(key)
+	}
+
+	return &token, nil
+}
+
+func encodePageToken(token *PageToken) (string, *contract.Error) {
+	var encoded strings.Builder
+
+	// the encoder is closed to flush the last, partial, block.
+	encoder := base64.NewEncoder(base64.StdEncoding, &encoded)
+	if err := errors.Join(json.NewEncoder(encoder).Encode(token), encoder.Close()); err != nil {
+		return "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"error encoding 'nextPageToken' value",
+			err,
+		)
+	}
+
+	return encoded.String(), nil
+}
+
+// searchHash identifies a search by the parameters which select and order its results, so that
+// a cursor cannot be used with another search.
+func searchHash(parameters ...any) string {
+	encoded, _ := json.Marshal(parameters) //nolint:errchkjson // slices of strings and numbers.
+	sum := sha256.Sum256(encoded)
+
+	return hex.EncodeToString(sum[:8])
+}
+
+// sortKey is one of the expressions the results of a search are sorted by. The expressions
+// which are not nullable can be compared without special casing NULL.
+type sortKey struct {
+	expr     string
+	desc     bool
+	nullable bool
+}
+
+// sortValue converts a value read from the database, or decoded from a page token, to one
+// that can be both encoded in a page token and bound to a query.
+func sortValue(value any) any {
+	switch typedValue := value.(type) {
+	case []byte:
+		return string(typedValue)
+	case json.Number:
+		if integer, err := typedValue.Int64(); err == nil {
+			return integer
+		}
+
+		if float, err := typedValue.Float64(); err == nil {
+			return float
+		}
+
+		return typedValue.String()
+	default:
+		return value
+	}
+}
+
+// sortValues reads the values of sortKeys of the single result of transaction.
+func sortValues(transaction *gorm.DB, sortKeys []sortKey) ([]any, *contract.Error) {
+	columns := make([]string, 0, len(sortKeys))
+	for index, key := range sortKeys {
+		columns = append(columns, fmt.Sprintf("%s AS sort_key_%d", key.expr, index))
+	}
+
+	// the order of the search may refer to aliases of its own selection.
+	delete(transaction.Statement.Clauses, "ORDER BY")
+
+	row := map[string]any{}
+	if err := transaction.Select(strings.Join(columns, ", ")).Take(&row).Error; err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"error reading the sort keys of the last result",
+			err,
+		)
+	}
+
+	values := make([]any, 0, len(sortKeys))
+	for index := range sortKeys {
+		values = append(values, sortValue(row[fmt.Sprintf("sort_key_%d", index)]))
+	}
+
+	return values, nil
+}
+
+// keysetCondition selects the results sorted after values, by comparing sortKeys in turn:
+// (k1 after v1) OR (k1 = v1 AND k2 after v2) OR ...
+// NULL is larger than any value on Postgres, and smaller on the other databases.
+func keysetCondition(database *gorm.DB, sortKeys []sortKey, values []any) clause.Expr {
+	nullsLarge := database.Dialector.Name() == "postgres"
+
+	var (
+		alternatives []string
+		equalities   []string
+		vars         []any
+		equalVars    []any
+	)
+
+	for index, key := range sortKeys {
+		value := values[index]
+
+		// NULL comes after the values when it is large and the order ascending, or the reverse.
+		nullsAfter := nullsLarge != key.desc
+
+		var (
+			after     string
+			afterVars []any
+		)
+
+		operator := ">"
+		if key.desc {
+			operator = "<"
+		}
+
+		switch {
+		case value == nil && nullsAfter:
+			// nothing is sorted after NULL.
+		case value == nil:
+			after = key.expr + " IS NOT NULL"
+		case key.nullable && nullsAfter:
+			after = fmt.Sprintf("(%s %s ? OR %s IS NULL)", key.expr, operator, key.expr)
+			afterVars = []any{value}
+		default:
+			after = fmt.Sprintf("%s %s ?", key.expr, operator)
+			afterVars = []any{value}
+		}
+
+		if after != "" {
+			conditions := append(slices.Clone(equalities), after)
+			alternatives = append(alternatives, "("+strings.Join(conditions, " AND ")+")")
+			vars = append(append(vars, equalVars...), afterVars...)
+		}
+
+		if value == nil {
+			equalities = append(equalities, key.expr+" IS NULL")
+		} else {
+			equalities = append(equalities, key.expr+" = ?")
+			equalVars = append(equalVars, value)
+		}
+	}
+
+	if len(alternatives) == 0 {
+		return clause.Expr{SQL: "1 = 0"}
+	}
+
+	return clause.Expr{SQL: "(" + strings.Join(alternatives, " OR ") + ")", Vars: vars}
+}
+
+// applyPageToken starts the results of transaction at token, by cursor or by offset. hash
+// identifies the search, see searchHash.
+func applyPageToken(
+	database, transaction *gorm.DB, token *PageToken, sortKeys []sortKey, hash string,
+) *contract.Error {
+	if token.Keys == nil {
+		transaction.Offset(int(token.Offset))
+
+		return nil
+	}
+
+	if token.Hash != hash || len(token.Keys) != len(sortKeys) {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"Invalid page token: it was issued for a different filter or order_by",
+		)
+	}
+
+	transaction.Where(keysetCondition(database, sortKeys, token.Keys))
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+)
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, token := range []*PageToken{
+		{Offset: 100},
+		{Offset: 4, Keys: []any{int64(3), 0.5, nil, "r15"}, Hash: searchHash("1", "", []string{})},
+	} {
+		encoded, contractErr := encodePageToken(token)
+		require.Nil(t, contractErr)
+
+		decoded, contractErr := decodePageToken(encoded)
+		require.Nil(t, contractErr)
+		assert.Equal(t, token, decoded)
+	}
+
+	_, contractErr := decodePageToken("not a token")
+	require.NotNil(t, contractErr)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractErr.Code))
+}
+
+func TestApplyPageToken(t *testing.T) {
+	t.Parallel()
+
+	sortKeys := []sortKey{
+		{expr: "runs.start_time", desc: true, nullable: true},
+		{expr: "runs.run_uuid"},
+	}
+
+	for dialectorName, test := range map[string]struct {
+		newDialector func() gorm.Dialector
+		expectedSQL  string
+	}{
+		"postgres": {
+			newDialector: newPostgresDialector,
+			expectedSQL: `SELECT * FROM "runs"
+			WHERE ((runs.start_time < $1) OR (runs.start_time = $2 AND runs.run_uuid > $3))`,
+		},
+		"sqlite": {
+			newDialector: newSqliteDialector,
+			expectedSQL: `SELECT * FROM runs
+			WHERE (((runs.start_time < ? OR runs.start_time IS NULL))
+			OR (runs.start_time = ? AND runs.run_uuid > ?))`,
+		},
+	} {
+		t.Run(dialectorName, func(t *testing.T) {
+			t.Parallel()
+
+			database, err := gorm.Open(test.newDialector(), &gorm.Config{DryRun: true})
+			require.NoError(t, err)
+
+			transaction := database.Model(&models.Run{})
+
+			token := &PageToken{Offset: 4, Keys: []any{int64(3), "r15"}, Hash: "hash"}
+			require.Nil(t, applyPageToken(database, transaction, token, sortKeys, "hash"))
+			require.NoError(t, transaction.Find(&[]models.Run{}).Error)
+
+			assert.Equal(t, removeWhitespace(test.expectedSQL), removeWhitespace(transaction.Statement.SQL.String()))
+			assert.Equal(t, []any{int64(3), int64(3), "r15"}, transaction.Statement.Vars)
+
+			contractErr := applyPageToken(database, database.Model(&models.Run{}), token, sortKeys, "other")
+			require.NotNil(t, contractErr)
+			assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractErr.Code))
+		})
+	}
+}
@@ -126,7 +126,7 @@ func (s TrackingSQLStore) logParamsWithTransaction(
 func (s TrackingSQLStore) LogParam(
 	ctx context.Context, runID string, param *entities.Param,
 ) *contract.Error {
-	err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		if err := checkRunIsActive(transaction, runID); err != nil {
 			return err
 		}
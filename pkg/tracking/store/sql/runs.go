@@ -59,14 +59,15 @@ func checkRunIsActive(transaction *gorm.DB, runID string) *contract.Error {
 	return nil
 }
 
-//nolint:funlen
-func (s TrackingSQLStore) SearchRuns(
+// searchRunsQuery returns the query of a page of SearchRuns, the decoded pageToken and the
+// hash of the search.
+func (s TrackingSQLStore) searchRunsQuery(
 	ctx context.Context,
 	experimentIDs []string, filter string,
 	runViewType protos.ViewType, maxResults int, orderBy []string, pageToken string,
-) ([]*entities.Run, string, *contract.Error) {
+) (*gorm.DB, *PageToken, string, *contract.Error) {
 	// ViewType
-	transaction := s.db.WithContext(ctx).Where(
+	transaction := s.reader(ctx).Where(
 		"runs.experiment_id IN ?", experimentIDs,
 	).Where(
 		"runs.lifecycle_stage IN ?", applyLifecycleStagesFilter(runViewType),
@@ -75,22 +76,42 @@ func (s TrackingSQLStore) SearchRuns(
 	// MaxResults
 	transaction.Limit(maxResults)
 
-	// PageToken
-	offset, contractError := getOffset(pageToken)
+	token, contractError := decodePageToken(pageToken)
 	if contractError != nil {
-		return nil, "", contractError
+		return nil, nil, "", contractError
 	}
 
-	transaction.Offset(offset)
-
 	// Filter
 	contractError = applyFilter(ctx, s.db, transaction, filter)
 	if contractError != nil {
-		return nil, "", contractError
+		return nil, nil, "", contractError
 	}
 
 	// OrderBy
-	contractError = applyOrderBy(ctx, s.db, transaction, orderBy)
+	sortKeys, contractError := applyOrderBy(ctx, s.db, transaction, orderBy)
+	if contractError != nil {
+		return nil, nil, "", contractError
+	}
+
+	// PageToken
+	hash := searchHash(experimentIDs, filter, runViewType, orderBy)
+
+	contractError = applyPageToken(s.db, transaction, token, sortKeys, hash)
+	if contractError != nil {
+		return nil, nil, "", contractError
+	}
+
+	return transaction, token, hash, nil
+}
+
+func (s TrackingSQLStore) SearchRuns(
+	ctx context.Context,
+	experimentIDs []string, filter string,
+	runViewType protos.ViewType, maxResults int, orderBy []string, pageToken string,
+) ([]*entities.Run, string, *contract.Error) {
+	transaction, token, hash, contractError := s.searchRunsQuery(
+		ctx, experimentIDs, filter, runViewType, maxResults, orderBy, pageToken,
+	)
 	if contractError != nil {
 		return nil, "", contractError
 	}
@@ -125,7 +146,9 @@ func (s TrackingSQLStore) SearchRuns(
 		entityRuns[i] = run.ToEntity()
 	}
 
-	nextPageToken, contractError := mkNextPageToken(len(runs), maxResults, offset)
+	nextPageToken, contractError := s.runsNextPageToken(
+		ctx, transaction.Session(&gorm.Session{NewDB: true}), runs, maxResults, orderBy, token, hash,
+	)
 	if contractError != nil {
 		return nil, "", contractError
 	}
@@ -133,6 +156,37 @@ func (s TrackingSQLStore) SearchRuns(
 	return entityRuns, nextPageToken, nil
 }
 
+// runsNextPageToken returns the cursor of the last run of a full page, which the next page
+// starts after, reading it from the database the page was read from.
+//
+//nolint:gosec // disable G115
+func (s TrackingSQLStore) runsNextPageToken(
+	ctx context.Context, database *gorm.DB,
+	runs []models.Run, maxResults int, orderBy []string, token *PageToken, hash string,
+) (string, *contract.Error) {
+	if len(runs) != maxResults || maxResults == 0 {
+		return "", nil
+	}
+
+	transaction := database.Model(&models.Run{}).Where("runs.run_uuid = ?", runs[len(runs)-1].ID)
+
+	sortKeys, contractError := applyOrderBy(ctx, s.db, transaction, orderBy)
+	if contractError != nil {
+		return "", contractError
+	}
+
+	keys, contractError := sortValues(transaction, sortKeys)
+	if contractError != nil {
+		return "", contractError
+	}
+
+	return encodePageToken(&PageToken{
+		Offset: token.Offset + int32(maxResults),
+		Keys:   keys,
+		Hash:   hash,
+	})
+}
+
 const RunIDMaxLength = 32
 
 const (
@@ -194,8 +248,10 @@ func ensureRunName(runModel *models.Run) *contract.Error {
 }
 
 func (s TrackingSQLStore) GetRun(ctx context.Context, runID string) (*entities.Run, *contract.Error) {
+	database := s.reader(ctx)
+
 	var run models.Run
-	if err := s.db.WithContext(ctx).Where(
+	if err := database.Where(
 		"run_uuid = ?", runID,
 	).Preload(
 		"Tags",
@@ -218,7 +274,7 @@ func (s TrackingSQLStore) GetRun(ctx context.Context, runID string) (*entities.R
 		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to get run", err)
 	}
 
-	if err := s.db.WithContext(ctx).Where(
+	if err := database.Where(
 		"source_type = ? AND destination_type = ? AND source_id = ?",
 		models.SourceTypeRunOutput, models.DestinationTypeModelOutput, runID,
 	).Find(&run.Outputs).Error; err != nil {
@@ -228,6 +284,26 @@ func (s TrackingSQLStore) GetRun(ctx context.Context, runID string) (*entities.R
 	return run.ToEntity(), nil
 }
 
+func (s TrackingSQLStore) GetRunArtifactURI(ctx context.Context, runID string) (string, *contract.Error) {
+	var run models.Run
+	if err := s.db.WithContext(ctx).Select(
+		"artifact_uri",
+	).Where(
+		"run_uuid = ?", runID,
+	).First(&run).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("Run with id=%s not found", runID),
+			)
+		}
+
+		return "", contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to get run", err)
+	}
+
+	return run.ArtifactURI, nil
+}
+
 //nolint:funlen
 func (s TrackingSQLStore) CreateRun(
 	ctx context.Context,
@@ -322,7 +398,7 @@ func (s TrackingSQLStore) UpdateRun(
 		endTimeValue = sql.NullInt64{Int64: *endTime, Valid: true}
 	}
 
-	if err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		if err := transaction.Model(&models.Run{}).
 			Where("run_uuid = ?", runID).
 			Updates(&models.Run{
@@ -390,7 +466,7 @@ func (s TrackingSQLStore) RestoreRun(ctx context.Context, runID string) *contrac
 func (s TrackingSQLStore) LogBatch(
 	ctx context.Context, runID string, metrics []*entities.Metric, params []*entities.Param, tags []*entities.RunTag,
 ) *contract.Error {
-	err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	err := s.transactionWithCopy(ctx, func(transaction *gorm.DB) error {
 		contractError := checkRunIsActive(transaction, runID)
 		if contractError != nil {
 			return contractError
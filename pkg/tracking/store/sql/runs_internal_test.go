@@ -17,6 +17,7 @@ import (
 	"gorm.io/driver/sqlserver"
 	"gorm.io/gorm"
 
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
 	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
@@ -283,6 +284,115 @@ var tests = []testData{
 		},
 		expectedVars: []any{"accuracy", 0.72, "batch_size", "%a"},
 	},
+	{
+		name:  "OrQuery",
+		query: "(metrics.acc > 0.9 OR tags.stage = 'prod') AND params.lr = '0.01'",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "run_uuid" FROM "runs"
+	JOIN (SELECT "run_uuid","value" FROM "params" WHERE key = $1 AND value = $2)
+	AS filter_1 ON runs.run_uuid = filter_1.run_uuid
+	WHERE (
+		EXISTS (SELECT 1 FROM (SELECT "run_uuid","value" FROM "latest_metrics" WHERE key = $3 AND value > $4)
+		AS filter_0_0 WHERE runs.run_uuid = filter_0_0.run_uuid)
+		OR EXISTS (SELECT 1 FROM (SELECT "run_uuid","value" FROM "tags" WHERE key = $5 AND value = $6)
+		AS filter_0_1 WHERE runs.run_uuid = filter_0_1.run_uuid)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+			"sqlite": `
+	SELECT run_uuid FROM runs
+	JOIN (SELECT run_uuid,value FROM params WHERE key = ? AND value = ?)
+	AS filter_1 ON runs.run_uuid = filter_1.run_uuid
+	WHERE (
+		EXISTS (SELECT 1 FROM (SELECT run_uuid,value FROM latest_metrics WHERE key = ? AND value > ?)
+		AS filter_0_0 WHERE runs.run_uuid = filter_0_0.run_uuid)
+		OR EXISTS (SELECT 1 FROM (SELECT run_uuid,value FROM tags WHERE key = ? AND value = ?)
+		AS filter_0_1 WHERE runs.run_uuid = filter_0_1.run_uuid)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+			"sqlserver": `
+	SELECT "run_uuid" FROM "runs"
+	JOIN (SELECT "run_uuid","value" FROM "params" WHERE key = @p1 AND value = @p2)
+	AS filter_1 ON runs.run_uuid = filter_1.run_uuid
+	WHERE (
+		EXISTS (SELECT 1 FROM (SELECT "run_uuid","value" FROM "latest_metrics" WHERE key = @p3 AND value > @p4)
+		AS filter_0_0 WHERE runs.run_uuid = filter_0_0.run_uuid)
+		OR EXISTS (SELECT 1 FROM (SELECT "run_uuid","value" FROM "tags" WHERE key = @p5 AND value = @p6)
+		AS filter_0_1 WHERE runs.run_uuid = filter_0_1.run_uuid)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+			"mysql": `
+	SELECT run_uuid FROM runs
+	JOIN (SELECT run_uuid,value FROM params WHERE key = ? AND value = ?)
+	AS filter_1 ON runs.run_uuid = filter_1.run_uuid
+	WHERE (
+		EXISTS (SELECT 1 FROM (SELECT run_uuid,value FROM latest_metrics WHERE key = ? AND value > ?)
+		AS filter_0_0 WHERE runs.run_uuid = filter_0_0.run_uuid)
+		OR EXISTS (SELECT 1 FROM (SELECT run_uuid,value FROM tags WHERE key = ? AND value = ?)
+		AS filter_0_1 WHERE runs.run_uuid = filter_0_1.run_uuid)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+		},
+		expectedVars: []any{"lr", "0.01", "acc", 0.9, "stage", "prod"},
+	},
+	{
+		name:  "NotQuery",
+		query: "NOT attributes.status = 'FAILED' AND NOT (tags.stage = 'prod' OR params.lr ILIKE '0.1%')",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "run_uuid" FROM "runs"
+	WHERE NOT (runs.status = $1)
+	AND NOT ((
+		EXISTS (SELECT 1 FROM (SELECT "run_uuid","value" FROM "tags" WHERE key = $2 AND value = $3)
+		AS filter_1_0 WHERE runs.run_uuid = filter_1_0.run_uuid)
+		OR EXISTS (SELECT 1 FROM (SELECT "run_uuid","value" FROM "params" WHERE key = $4 AND value ILIKE $5)
+		AS filter_1_1 WHERE runs.run_uuid = filter_1_1.run_uuid)
+	))
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+			"sqlite": `
+	SELECT run_uuid FROM runs
+	WHERE NOT (runs.status = ?)
+	AND NOT ((
+		EXISTS (SELECT 1 FROM (SELECT run_uuid,value FROM tags WHERE key = ? AND value = ?)
+		AS filter_1_0 WHERE runs.run_uuid = filter_1_0.run_uuid)
+		OR EXISTS (SELECT 1 FROM (SELECT run_uuid,value FROM params WHERE key = ? AND LOWER(value) LIKE ?)
+		AS filter_1_1 WHERE runs.run_uuid = filter_1_1.run_uuid)
+	))
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+		},
+		expectedVars: []any{"FAILED", "stage", "prod", "lr", "0.1%"},
+	},
+	{
+		name:  "NullQuery",
+		query: "metrics.accuracy IS NULL AND params.lr IS NOT NULL AND (tags.stage IS NULL OR tags.stage = 'dev')",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "run_uuid" FROM "runs"
+	JOIN (SELECT "run_uuid" FROM "params" WHERE key = $1) AS filter_1 ON runs.run_uuid = filter_1.run_uuid
+	WHERE NOT EXISTS (SELECT 1 FROM (SELECT "run_uuid" FROM "latest_metrics" WHERE key = $2)
+		AS filter_0 WHERE runs.run_uuid = filter_0.run_uuid)
+	AND ((
+		NOT EXISTS (SELECT 1 FROM (SELECT "run_uuid" FROM "tags" WHERE key = $3)
+		AS filter_2_0 WHERE runs.run_uuid = filter_2_0.run_uuid)
+		OR EXISTS (SELECT 1 FROM (SELECT "run_uuid","value" FROM "tags" WHERE key = $4 AND value = $5)
+		AS filter_2_1 WHERE runs.run_uuid = filter_2_1.run_uuid)
+	))
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+			"mysql": "" +
+				"SELECT `run_uuid` FROM `runs`" +
+				" JOIN (SELECT `run_uuid` FROM `params` WHERE key = ?) AS filter_1 ON runs.run_uuid = filter_1.run_uuid" +
+				" WHERE NOT EXISTS (SELECT 1 FROM (SELECT `run_uuid` FROM `latest_metrics` WHERE key = ?)" +
+				" AS filter_0 WHERE runs.run_uuid = filter_0.run_uuid)" +
+				" AND ((" +
+				"NOT EXISTS (SELECT 1 FROM (SELECT `run_uuid` FROM `tags` WHERE key = ?)" +
+				" AS filter_2_0 WHERE runs.run_uuid = filter_2_0.run_uuid)" +
+				" OR EXISTS (SELECT 1 FROM (SELECT `run_uuid`,`value` FROM `tags` WHERE key = ? AND value = ?)" +
+				" AS filter_2_1 WHERE runs.run_uuid = filter_2_1.run_uuid)" +
+				"))" +
+				" ORDER BY runs.start_time DESC,runs.run_uuid",
+		},
+		expectedVars: []any{"lr", "accuracy", "stage", "stage", "dev"},
+	},
 	{
 		name:    "OrderByStartTimeASC",
 		query:   "",
@@ -388,7 +498,7 @@ func assertTestData(
 		t.Fatal("contractErr: ", contractErr)
 	}
 
-	contractErr = applyOrderBy(context.Background(), database, transaction, testData.orderBy)
+	_, contractErr = applyOrderBy(context.Background(), database, transaction, testData.orderBy)
 	if contractErr != nil {
 		t.Fatal("contractErr: ", contractErr)
 	}
@@ -439,6 +549,12 @@ func TestInvalidSearchRunsQuery(t *testing.T) {
 	if contractErr == nil {
 		t.Fatal("expected contract error")
 	}
+
+	// the location of the error is part of the message, which is what the clients get.
+	contractErr = applyFilter(context.Background(), database, transaction, "attributes.start_tme > 0")
+	require.NotNil(t, contractErr)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractErr.Code))
+	assert.Contains(t, contractErr.Message, "Did you mean 'start_time'? at column 12 near \"start_tme\"")
 }
 
 //nolint:funlen
@@ -492,6 +608,14 @@ func TestOrderByClauseParsing(t *testing.T) {
 				order:      utils.PtrTo("ASC"),
 			},
 		},
+		{
+			input: "Tags.`mlflow.runName` desc",
+			expected: orderByExpr{
+				identifier: utils.PtrTo("tag"),
+				key:        "mlflow.runName",
+				order:      utils.PtrTo("DESC"),
+			},
+		},
 	}
 
 	for _, testData := range testData {
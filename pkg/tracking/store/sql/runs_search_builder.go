@@ -2,8 +2,6 @@ package sql
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -13,7 +11,7 @@ import (
 
 	"github.com/mlflow/mlflow-go-backend/pkg/contract"
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
-	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go-backend/pkg/sql"
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
 	"github.com/mlflow/mlflow-go-backend/pkg/utils"
@@ -43,163 +41,112 @@ func applyLifecycleStagesFilter(runViewType protos.ViewType) []models.LifecycleS
 }
 
 func getOffset(pageToken string) (int, *contract.Error) {
-	if pageToken != "" {
-		var token PageToken
-		if err := json.NewDecoder(
-			base64.NewDecoder(
-				base64.StdEncoding,
-				strings.NewReader(pageToken),
-			),
-		).Decode(&token); err != nil {
-			return 0, contract.NewErrorWith(
-				protos.ErrorCode_INVALID_PARAMETER_VALUE,
-				fmt.Sprintf("Invalid page token: %q", pageToken),
-				err,
-			)
-		}
-
-		return int(token.Offset), nil
+	token, contractError := decodePageToken(pageToken)
+	if contractError != nil {
+		return 0, contractError
 	}
 
-	return 0, nil
+	return int(token.Offset), nil
 }
 
-//nolint:funlen,cyclop,gocognit
-func applyFilter(ctx context.Context, database, transaction *gorm.DB, filter string) *contract.Error {
-	filterConditions, err := query.ParseFilter(filter)
-	if err != nil {
-		return contract.NewErrorWith(
-			protos.ErrorCode_INVALID_PARAMETER_VALUE,
-			"error parsing search filter",
-			err,
-		)
+// runsFilterTranslator translates the comparisons of a runs filter. The subqueries select
+// the matching runs in run_uuid, or destination_id for datasets.
+type runsFilterTranslator struct{}
+
+//nolint:funlen
+func (runsFilterTranslator) TranslateFilter(
+	database *gorm.DB, clause *parser.ValidCompareExpr,
+) (*sql.Filter, *contract.Error) {
+	var kind any
+
+	key := clause.Key
+
+	switch clause.Identifier {
+	case parser.Metric:
+		kind = &models.LatestMetric{}
+	case parser.Parameter:
+		kind = &models.Param{}
+	case parser.Tag:
+		kind = &models.Tag{}
+	case parser.Dataset:
+		kind = &models.Dataset{}
+	case parser.Attribute:
+		kind = nil
 	}
 
-	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterConditions)
-
-	for index, clause := range filterConditions {
-		var kind any
-
-		key := clause.Key
-		comparison := strings.ToUpper(clause.Operator.String())
-		value := clause.Value
-
-		switch clause.Identifier {
-		case parser.Metric:
-			kind = &models.LatestMetric{}
-		case parser.Parameter:
-			kind = &models.Param{}
-		case parser.Tag:
-			kind = &models.Tag{}
-		case parser.Dataset:
-			kind = &models.Dataset{}
-		case parser.Attribute:
-			kind = nil
-		}
-
-		// Treat "attributes.run_name == <value>" as "tags.`mlflow.runName` == <value>".
-		// The name column in the runs table is empty for runs logged in MLflow <= 1.29.0.
-		if key == "run_name" {
-			kind = &models.Tag{}
-			key = utils.TagRunName
-		}
-
-		isSqliteAndILike := database.Dialector.Name() == "sqlite" && comparison == "ILIKE"
-		table := fmt.Sprintf("filter_%d", index)
-
-		switch {
-		case kind == nil:
-			if isSqliteAndILike {
-				key = fmt.Sprintf("LOWER(runs.%s)", key)
-				comparison = "LIKE"
-
-				if str, ok := value.(string); ok {
-					value = strings.ToLower(str)
-				}
-
-				transaction.Where(fmt.Sprintf("%s %s ?", key, comparison), value)
-			} else {
-				transaction.Where(fmt.Sprintf("runs.%s %s ?", key, comparison), value)
-			}
-		case clause.Identifier == parser.Dataset && key == "context":
-			// SELECT *
-			// FROM runs
-			// JOIN (
-			//   SELECT inputs.destination_id AS run_uuid
-			//   FROM inputs
-			//   JOIN input_tags
-			//   ON inputs.input_uuid = input_tags.input_uuid
-			//   AND input_tags.name = 'mlflow.data.context'
-			//   AND input_tags.value %s ?
-			//   WHERE inputs.destination_type = 'RUN'
-			// ) AS filter_0
-			// ON runs.run_uuid = filter_0.run_uuid
-			valueColumn := "input_tags.value "
-			if isSqliteAndILike {
-				valueColumn = "LOWER(input_tags.value) "
-				comparison = "LIKE"
-
-				if str, ok := value.(string); ok {
-					value = strings.ToLower(str)
-				}
-			}
-
-			transaction.Joins(
-				fmt.Sprintf("JOIN (?) AS %s ON runs.run_uuid = %s.run_uuid", table, table),
-				database.Select("inputs.destination_id AS run_uuid").
-					Joins(
-						"JOIN input_tags ON inputs.input_uuid = input_tags.input_uuid"+
-							" AND input_tags.name = 'mlflow.data.context'"+
-							" AND "+valueColumn+comparison+" ?",
-						value,
-					).
-					Where("inputs.destination_type = 'RUN'").
-					Model(&models.Input{}),
-			)
-		case clause.Identifier == parser.Dataset:
-			// add join with datasets
-			// JOIN (
-			// 		SELECT "experiment_id", key
-			//		FROM datasests d
-			// 		JOIN inputs ON inputs.source_id = datasets.dataset_uuid
-			//		WHERE key comparison value
-			// ) AS filter_0 ON runs.experiment_id = dataset.experiment_id
-			//
-			// columns: name, digest, context
-			where := key + " " + comparison + " ?"
-			if isSqliteAndILike {
-				where = "LOWER(" + key + ") LIKE ?"
-
-				if str, ok := value.(string); ok {
-					value = strings.ToLower(str)
-				}
-			}
-
-			transaction.Joins(
-				fmt.Sprintf("JOIN (?) AS %s ON runs.run_uuid = %s.destination_id", table, table),
-				database.Model(kind).
-					Joins("JOIN inputs ON inputs.source_id = datasets.dataset_uuid").
-					Where(where, value).
-					Select("destination_id", key),
-			)
-		default:
-			where := fmt.Sprintf("value %s ?", comparison)
-			if isSqliteAndILike {
-				where = "LOWER(value) LIKE ?"
-
-				if str, ok := value.(string); ok {
-					value = strings.ToLower(str)
-				}
-			}
+	// Treat "attributes.run_name == <value>" as "tags.`mlflow.runName` == <value>".
+	// The name column in the runs table is empty for runs logged in MLflow <= 1.29.0.
+	if key == "run_name" {
+		kind = &models.Tag{}
+		key = utils.TagRunName
+	}
 
-			transaction.Joins(
-				fmt.Sprintf("JOIN (?) AS %s ON runs.run_uuid = %s.run_uuid", table, table),
-				database.Select("run_uuid", "value").Where("key = ?", key).Where(where, value).Model(kind),
-			)
-		}
+	switch {
+	case kind == nil:
+		where, value := sql.ComparisonCondition(database, "runs."+key, clause)
+
+		return &sql.Filter{Where: where, Value: value}, nil
+	case clause.Identifier == parser.Dataset && key == "context":
+		// SELECT inputs.destination_id AS run_uuid
+		// FROM inputs
+		// JOIN input_tags
+		// ON inputs.input_uuid = input_tags.input_uuid
+		// AND input_tags.name = 'mlflow.data.context'
+		// AND input_tags.value %s ?
+		// WHERE inputs.destination_type = 'RUN'
+		where, value := sql.ComparisonCondition(database, "input_tags.value", clause)
+
+		return &sql.Filter{
+			Subquery: database.Select("inputs.destination_id AS run_uuid").
+				Joins(
+					"JOIN input_tags ON inputs.input_uuid = input_tags.input_uuid"+
+						" AND input_tags.name = 'mlflow.data.context'"+
+						" AND "+where,
+					value,
+				).
+				Where("inputs.destination_type = 'RUN'").
+				Model(&models.Input{}),
+		}, nil
+	case clause.Identifier == parser.Dataset:
+		// SELECT destination_id, key
+		// FROM datasets
+		// JOIN inputs ON inputs.source_id = datasets.dataset_uuid
+		// WHERE key comparison value
+		//
+		// columns: name, digest, context
+		return &sql.Filter{
+			Subquery: database.Model(kind).
+				Joins("JOIN inputs ON inputs.source_id = datasets.dataset_uuid").
+				Where(sql.ComparisonCondition(database, key, clause)).
+				Select("destination_id", key),
+			Columns: []string{"destination_id"},
+		}, nil
+	case clause.Operator.IsNullCheck():
+		// SELECT run_uuid FROM latest_metrics WHERE key = ?
+		//
+		// the runs having the key for IS NOT NULL, the runs missing from it for IS NULL.
+		return &sql.Filter{
+			Subquery: database.Select("run_uuid").Where("key = ?", key).Model(kind),
+			AntiJoin: clause.Operator == parser.IsNull,
+		}, nil
+	default:
+		return &sql.Filter{
+			Subquery: database.Select("run_uuid", "value").Where("key = ?", key).Where(
+				sql.ComparisonCondition(database, "value", clause),
+			).Model(kind),
+		}, nil
 	}
+}
 
-	return nil
+var runsFilterEntity = &sql.FilterEntity{
+	Table:      "runs",
+	Keys:       []string{"run_uuid"},
+	Schema:     parser.RunSchema,
+	Translator: runsFilterTranslator{},
+}
+
+func applyFilter(ctx context.Context, database, transaction *gorm.DB, filter string) *contract.Error {
+	return sql.ApplyFilter(ctx, database, transaction, runsFilterEntity, filter)
 }
 
 type orderByExpr struct {
@@ -268,7 +215,7 @@ func handleOutsideQuote(
 // Process an order by input string to split the string into the separate parts.
 // We can't simply split by space, because the column name could be wrapped in quotes, e.g. "Run name" ASC.
 func splitOrderByClauseWithQuotes(input string) []string {
-	input = strings.ToLower(strings.Trim(input, " "))
+	input = strings.Trim(input, " ")
 
 	var result []string
 
@@ -311,6 +258,15 @@ func translateIdentifierAlias(identifier string) string {
 	}
 }
 
+func hasCaseSensitiveKeys(identifier string) bool {
+	switch identifier {
+	case metric, "parameter", "tag", requestMetadata, "metadata":
+		return true
+	default:
+		return false
+	}
+}
+
 func processOrderByClause(input string) (orderByExpr, error) {
 	parts := splitOrderByClauseWithQuotes(input)
 
@@ -320,13 +276,18 @@ func processOrderByClause(input string) (orderByExpr, error) {
 
 	var expr orderByExpr
 
-	identifierKey := strings.Split(parts[0], ".")
+	// only the first dot separates the identifier from the key, which may hold dots itself,
+	// e.g. tags.`mlflow.user`.
+	identifierKey := strings.SplitN(parts[0], ".", identifierAndKeyLength)
 
+	expr.key = identifierKey[len(identifierKey)-1]
 	if len(identifierKey) == identifierAndKeyLength {
-		expr.identifier = utils.PtrTo(translateIdentifierAlias(identifierKey[0]))
-		expr.key = orderByKeyAlias(identifierKey[1])
-	} else if len(identifierKey) == 1 {
-		expr.key = orderByKeyAlias(identifierKey[0])
+		expr.identifier = utils.PtrTo(translateIdentifierAlias(strings.ToLower(identifierKey[0])))
+	}
+
+	// the keys of the metrics, params, tags and request metadata are case sensitive, unlike the attributes.
+	if expr.identifier == nil || !hasCaseSensitiveKeys(*expr.identifier) {
+		expr.key = orderByKeyAlias(strings.ToLower(expr.key))
 	}
 
 	if len(parts) > 1 {
@@ -336,15 +297,20 @@ func processOrderByClause(input string) (orderByExpr, error) {
 	return expr, nil
 }
 
+// applyOrderBy orders the runs of transaction, and returns the keys they are sorted by.
+//
 //nolint:funlen, cyclop, gocognit
-func applyOrderBy(ctx context.Context, database, transaction *gorm.DB, orderBy []string) *contract.Error {
+func applyOrderBy(
+	ctx context.Context, database, transaction *gorm.DB, orderBy []string,
+) ([]sortKey, *contract.Error) {
 	startTimeOrder := false
 	columnSelection := "runs.*"
+	sortKeys := make([]sortKey, 0, 2*len(orderBy)+2) //nolint:mnd
 
 	for index, orderByClause := range orderBy {
 		orderByExpr, err := processOrderByClause(orderByClause)
 		if err != nil {
-			return contract.NewError(
+			return nil, contract.NewError(
 				protos.ErrorCode_INVALID_PARAMETER_VALUE,
 				fmt.Sprintf(
 					"invalid order_by clause %q.",
@@ -395,6 +361,12 @@ func applyOrderBy(ctx context.Context, database, transaction *gorm.DB, orderBy [
 			orderByExpr.key = table + ".value"
 		}
 
+		// the column the runs are sorted by, qualified to be usable in conditions.
+		sortColumn := orderByExpr.key
+		if kind == nil {
+			sortColumn = "runs." + orderByExpr.key
+		}
+
 		desc := false
 		if orderByExpr.order != nil {
 			desc = *orderByExpr.order == "DESC"
@@ -414,14 +386,12 @@ func applyOrderBy(ctx context.Context, database, transaction *gorm.DB, orderBy [
 				originalColumn = orderByExpr.key
 			}
 
-			columnSelection = fmt.Sprintf(
-				"%s, (CASE WHEN (%s IS NULL) THEN 1 ELSE 0 END) AS %s",
-				columnSelection,
-				originalColumn,
-				nullableColumnAlias,
-			)
+			nullable := fmt.Sprintf("(CASE WHEN (%s IS NULL) THEN 1 ELSE 0 END)", originalColumn)
+			columnSelection = fmt.Sprintf("%s, %s AS %s", columnSelection, nullable, nullableColumnAlias)
 
 			transaction.Order(nullableColumnAlias)
+
+			sortKeys = append(sortKeys, sortKey{expr: nullable})
 		}
 
 		// the metric table has the is_nan column
@@ -431,16 +401,17 @@ func applyOrderBy(ctx context.Context, database, transaction *gorm.DB, orderBy [
 				trueColumnValue = "1"
 			}
 
-			columnSelection = fmt.Sprintf(
-				"%s, (CASE WHEN (%s.is_nan = %s) THEN 1 WHEN (%s.value IS NULL) THEN 2 ELSE 0 END) AS %s",
-				columnSelection,
+			nullable := fmt.Sprintf(
+				"(CASE WHEN (%s.is_nan = %s) THEN 1 WHEN (%s.value IS NULL) THEN 2 ELSE 0 END)",
 				table,
 				trueColumnValue,
 				table,
-				nullableColumnAlias,
 			)
+			columnSelection = fmt.Sprintf("%s, %s AS %s", columnSelection, nullable, nullableColumnAlias)
 
 			transaction.Order(nullableColumnAlias)
+
+			sortKeys = append(sortKeys, sortKey{expr: nullable})
 		}
 
 		transaction.Order(clause.OrderByColumn{
@@ -449,42 +420,33 @@ func applyOrderBy(ctx context.Context, database, transaction *gorm.DB, orderBy [
 			},
 			Desc: desc,
 		})
+
+		sortKeys = append(sortKeys, sortKey{expr: sortColumn, desc: desc, nullable: true})
 	}
 
 	if !startTimeOrder {
 		transaction.Order("runs.start_time DESC")
+
+		sortKeys = append(sortKeys, sortKey{expr: "runs.start_time", desc: true, nullable: true})
 	}
 
 	transaction.Order("runs.run_uuid")
 
+	sortKeys = append(sortKeys, sortKey{expr: "runs.run_uuid"})
+
 	// mlflow orders all nullable columns to have null last.
 	// For each order by clause, an additional dynamic order clause was added.
 	// We need to include these columns in the select clause.
 	transaction.Select(columnSelection)
 
-	return nil
+	return sortKeys, nil
 }
 
 //nolint:gosec // disable G115
 func mkNextPageToken(runLength, maxResults, offset int) (string, *contract.Error) {
-	var nextPageToken string
-
-	if runLength == maxResults {
-		var token strings.Builder
-		if err := json.NewEncoder(
-			base64.NewEncoder(base64.StdEncoding, &token),
-		).Encode(PageToken{
-			Offset: int32(offset + maxResults),
-		}); err != nil {
-			return "", contract.NewErrorWith(
-				protos.ErrorCode_INTERNAL_ERROR,
-				"error encoding 'nextPageToken' value",
-				err,
-			)
-		}
-
-		nextPageToken = token.String()
+	if runLength != maxResults {
+		return "", nil
 	}
 
-	return nextPageToken, nil
+	return encodePageToken(&PageToken{Offset: int32(offset + maxResults)})
 }
@@ -2,6 +2,7 @@ package sql
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"gorm.io/gorm"
@@ -13,24 +14,60 @@ import (
 type TrackingSQLStore struct {
 	config *config.Config
 	db     *gorm.DB
+	// replicas route the reads tolerating replication lag, nil without read replicas.
+	replicas *sql.ReadReplicas
 }
 
 func NewTrackingSQLStore(ctx context.Context, config *config.Config) (*TrackingSQLStore, error) {
-	database, err := sql.NewDatabase(ctx, config.TrackingStoreURI)
+	database, err := sql.NewDatabase(ctx, config.TrackingStoreURI, config.Database)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database %q: %w", config.TrackingStoreURI, err)
 	}
 
-	return &TrackingSQLStore{
+	store := &TrackingSQLStore{
 		config: config,
 		db:     database,
-	}, nil
+	}
+
+	if len(config.TrackingStoreReadReplicaURIs) > 0 {
+		store.replicas, err = sql.NewReadReplicas(ctx, database, config.TrackingStoreReadReplicaURIs, config.Database)
+		if err != nil {
+			return nil, errors.Join(err, sql.CloseDatabase(database))
+		}
+	}
+
+	return store, nil
 }
 
 func (s TrackingSQLStore) Destroy() error {
+	if s.replicas != nil {
+		if err := s.replicas.Close(); err != nil {
+			return fmt.Errorf("failed to close read replicas: %w", err)
+		}
+	}
+
 	if err := sql.CloseDatabase(s.db); err != nil {
 		return fmt.Errorf("failed to close database: %w", err)
 	}
 
 	return nil
 }
+
+// transaction runs fn in a transaction, retried on transient database errors, see sql.Transaction.
+func (s TrackingSQLStore) transaction(ctx context.Context, fn func(transaction *gorm.DB) error) error {
+	return sql.Transaction(s.db.WithContext(ctx), fn) //nolint:wrapcheck
+}
+
+// transactionWithCopy runs fn like transaction, letting it stream rows with COPY on PostgreSQL.
+func (s TrackingSQLStore) transactionWithCopy(ctx context.Context, fn func(transaction *gorm.DB) error) error {
+	return sql.TransactionWithCopy(s.db.WithContext(ctx), fn) //nolint:wrapcheck
+}
+
+// reader returns the database the reads of ctx go to, a read replica when ctx allows it.
+func (s TrackingSQLStore) reader(ctx context.Context) *gorm.DB {
+	if s.replicas == nil {
+		return s.db.WithContext(ctx)
+	}
+
+	return s.replicas.DB(ctx)
+}
@@ -297,7 +297,7 @@ func (s TrackingSQLStore) EndTrace(
 		return nil, err
 	}
 
-	if err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		if err := transaction.Model(
 			&models.TraceInfo{},
 		).Where(
@@ -430,3 +430,127 @@ func (s TrackingSQLStore) DeleteTraces(
 	//nolint:gosec
 	return int32(len(traces)), nil
 }
+
+// searchTracesQuery returns the query of a page of the trace searches and its offset.
+func (s TrackingSQLStore) searchTracesQuery(
+	ctx context.Context,
+	experimentIDs []string,
+	filter string,
+	modelID string,
+	maxResults int,
+	orderBy []string,
+	pageToken string,
+) (*gorm.DB, int, *contract.Error) {
+	transaction := s.db.WithContext(ctx).Model(
+		&models.TraceInfo{},
+	).Where(
+		"trace_info.experiment_id IN ?", experimentIDs,
+	)
+
+	// MaxResults
+	transaction.Limit(maxResults)
+
+	// PageToken
+	offset, contractError := getOffset(pageToken)
+	if contractError != nil {
+		return nil, 0, contractError
+	}
+
+	transaction.Offset(offset)
+
+	// Filter
+	if contractError := applyTracesFilter(ctx, s.db, transaction, filter); contractError != nil {
+		return nil, 0, contractError
+	}
+
+	applyTracesModelFilter(s.db, transaction, modelID)
+
+	// OrderBy
+	if contractError := applyTracesOrderBy(ctx, s.db, transaction, orderBy); contractError != nil {
+		return nil, 0, contractError
+	}
+
+	return transaction, offset, nil
+}
+
+func (s TrackingSQLStore) searchTraces(
+	ctx context.Context,
+	experimentIDs []string,
+	filter string,
+	modelID string,
+	maxResults int,
+	orderBy []string,
+	pageToken string,
+) ([]models.TraceInfo, string, *contract.Error) {
+	transaction, offset, contractError := s.searchTracesQuery(
+		ctx, experimentIDs, filter, modelID, maxResults, orderBy, pageToken,
+	)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	var traces []models.TraceInfo
+	if err := transaction.Preload(
+		"Tags",
+	).Preload(
+		"TraceRequestMetadata",
+	).Find(
+		&traces,
+	).Error; err != nil {
+		return nil, "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"failed to query search traces",
+			err,
+		)
+	}
+
+	nextPageToken, contractError := mkNextPageToken(len(traces), maxResults, offset)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	return traces, nextPageToken, nil
+}
+
+func (s TrackingSQLStore) SearchTraces(
+	ctx context.Context,
+	experimentIDs []string,
+	filter string,
+	modelID string,
+	maxResults int,
+	orderBy []string,
+	pageToken string,
+) ([]*entities.TraceInfo, string, *contract.Error) {
+	traces, nextPageToken, err := s.searchTraces(ctx, experimentIDs, filter, modelID, maxResults, orderBy, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	entityTraces := make([]*entities.TraceInfo, 0, len(traces))
+	for _, trace := range traces {
+		entityTraces = append(entityTraces, trace.ToEntity())
+	}
+
+	return entityTraces, nextPageToken, nil
+}
+
+func (s TrackingSQLStore) SearchTracesV3(
+	ctx context.Context,
+	experimentIDs []string,
+	filter string,
+	maxResults int,
+	orderBy []string,
+	pageToken string,
+) ([]*entities.TraceInfoV3, string, *contract.Error) {
+	traces, nextPageToken, err := s.searchTraces(ctx, experimentIDs, filter, "", maxResults, orderBy, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	entityTraces := make([]*entities.TraceInfoV3, 0, len(traces))
+	for _, trace := range traces {
+		entityTraces = append(entityTraces, trace.ToTraceInfoV3Entity())
+	}
+
+	return entityTraces, nextPageToken, nil
+}
@@ -0,0 +1,202 @@
+package sql
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+)
+
+var tracesTests = []testData{
+	{
+		name:  "AttributeQuery",
+		query: "trace.status = 'OK' AND trace.timestamp_ms > 1711089570679",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT trace_info.* FROM "trace_info"
+	WHERE trace_info.status = $1 AND trace_info.timestamp_ms > $2
+	ORDER BY trace_info.timestamp_ms DESC,trace_info.request_id`,
+			"sqlite": `
+	SELECT trace_info.* FROM trace_info
+	WHERE trace_info.status = ? AND trace_info.timestamp_ms > ?
+	ORDER BY trace_info.timestamp_ms DESC,trace_info.request_id`,
+		},
+		expectedVars: []any{"OK", float64(1711089570679)},
+	},
+	{
+		name:  "TagAndMetadataQuery",
+		query: "tags.environment ILIKE 'prod%' AND request_metadata.`mlflow.user` = 'bob'",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT trace_info.* FROM "trace_info"
+	JOIN (SELECT "request_id","value" FROM "trace_tags" WHERE key = $1 AND value ILIKE $2)
+	AS filter_0 ON trace_info.request_id = filter_0.request_id
+	JOIN (SELECT "request_id","value" FROM "trace_request_metadata" WHERE key = $3 AND value = $4)
+	AS filter_1 ON trace_info.request_id = filter_1.request_id
+	ORDER BY trace_info.timestamp_ms DESC,trace_info.request_id`,
+			"sqlite": `
+	SELECT trace_info.* FROM trace_info
+	JOIN (SELECT request_id,value FROM trace_tags WHERE key = ? AND LOWER(value) LIKE ?)
+	AS filter_0 ON trace_info.request_id = filter_0.request_id
+	JOIN (SELECT request_id,value FROM trace_request_metadata WHERE key = ? AND value = ?)
+	AS filter_1 ON trace_info.request_id = filter_1.request_id
+	ORDER BY trace_info.timestamp_ms DESC,trace_info.request_id`,
+		},
+		expectedVars: []any{"environment", "prod%", "mlflow.user", "bob"},
+	},
+	{
+		name:  "NameQuery",
+		query: "name = 'predict'",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT trace_info.* FROM "trace_info"
+	JOIN (SELECT "request_id","value" FROM "trace_tags" WHERE key = $1 AND value = $2)
+	AS filter_0 ON trace_info.request_id = filter_0.request_id
+	ORDER BY trace_info.timestamp_ms DESC,trace_info.request_id`,
+		},
+		expectedVars: []any{"mlflow.traceName", "predict"},
+	},
+	{
+		name:    "OrderByTimestampAndTag",
+		query:   "",
+		orderBy: []string{"timestamp ASC", "tags.stage DESC"},
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT trace_info.*,
+	(CASE WHEN (trace_info.timestamp_ms IS NULL) THEN 1 ELSE 0 END) AS order_null_0,
+	(CASE WHEN (order_1.value IS NULL) THEN 1 ELSE 0 END) AS order_null_1
+	FROM "trace_info"
+	LEFT OUTER JOIN (SELECT "request_id","value" FROM "trace_tags" WHERE key = $1)
+	AS order_1 ON trace_info.request_id = order_1.request_id
+	ORDER BY order_null_0,"trace_info"."timestamp_ms",order_null_1,"order_1"."value" DESC,trace_info.request_id`,
+		},
+		expectedVars: []any{"stage"},
+	},
+	{
+		name:    "OrderByDottedTag",
+		query:   "",
+		orderBy: []string{"tags.`mlflow.traceName` DESC"},
+		expectedSQL: map[string]string{
+			"sqlite": `
+	SELECT trace_info.*,
+	(CASE WHEN (order_0.value IS NULL) THEN 1 ELSE 0 END) AS order_null_0
+	FROM trace_info
+	LEFT OUTER JOIN (SELECT request_id,value FROM trace_tags WHERE key = ?)
+	AS order_0 ON trace_info.request_id = order_0.request_id
+	ORDER BY order_null_0,order_0.value DESC,trace_info.timestamp_ms DESC,trace_info.request_id`,
+		},
+		expectedVars: []any{"mlflow.traceName"},
+	},
+}
+
+func TestSearchTraces(t *testing.T) {
+	t.Parallel()
+
+	for _, newDialector := range []func() gorm.Dialector{
+		newPostgresDialector,
+		newSqliteDialector,
+		newSQLServerDialector,
+		newMySQLDialector,
+	} {
+		database, err := gorm.Open(newDialector(), &gorm.Config{DryRun: true})
+		require.NoError(t, err)
+
+		dialectorName := database.Dialector.Name()
+
+		for _, testData := range tracesTests {
+			expectedSQL, ok := testData.expectedSQL[dialectorName]
+			if !ok {
+				continue
+			}
+
+			t.Run(testData.name+"_"+dialectorName, func(t *testing.T) {
+				t.Parallel()
+
+				transaction := database.Model(&models.TraceInfo{})
+
+				contractErr := applyTracesFilter(context.Background(), database, transaction, testData.query)
+				require.Nil(t, contractErr)
+
+				contractErr = applyTracesOrderBy(context.Background(), database, transaction, testData.orderBy)
+				require.Nil(t, contractErr)
+
+				require.NoError(t, transaction.Find(&[]models.TraceInfo{}).Error)
+
+				assert.Equal(t, removeWhitespace(expectedSQL), removeWhitespace(transaction.Statement.SQL.String()))
+				assert.Equal(t, testData.expectedVars, transaction.Statement.Vars)
+			})
+		}
+	}
+}
+
+func TestInvalidSearchTracesQuery(t *testing.T) {
+	t.Parallel()
+
+	database, err := gorm.Open(newSqliteDialector(), &gorm.Config{DryRun: true})
+	require.NoError(t, err)
+
+	for _, filter := range []string{
+		"metrics.accuracy > 0.5",
+		"trace.timestamp_ms = 'yesterday'",
+		"tags.environment = 1",
+		"trace.foo = 'bar'",
+	} {
+		transaction := database.Model(&models.TraceInfo{})
+		if contractErr := applyTracesFilter(context.Background(), database, transaction, filter); contractErr == nil {
+			t.Errorf("expected contract error for %q", filter)
+		}
+	}
+}
+
+// isGroup tells whether condition is a single parenthesized group.
+func isGroup(condition string) bool {
+	depth := 0
+
+	for i, char := range condition {
+		switch char {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 && i != len(condition)-1 {
+				return false
+			}
+		}
+	}
+
+	return strings.HasPrefix(condition, "(") && depth == 0
+}
+
+func TestSearchTracesOfModel(t *testing.T) {
+	t.Parallel()
+
+	database, err := gorm.Open(newSqliteDialector(), &gorm.Config{DryRun: true})
+	require.NoError(t, err)
+
+	modelCondition := "trace_info.request_id IN " +
+		"(SELECT `request_id` FROM `trace_request_metadata` WHERE key = ? AND value = ?)"
+
+	for _, filter := range []string{"tags.a = 'x'", "tags.a = 'x' OR tags.b = 'y'"} {
+		transaction := database.Model(&models.TraceInfo{})
+
+		contractErr := applyTracesFilter(context.Background(), database, transaction, filter)
+		require.Nil(t, contractErr)
+
+		applyTracesModelFilter(database, transaction, "m-1")
+
+		require.NoError(t, transaction.Find(&[]models.TraceInfo{}).Error)
+
+		// the model condition is not bound by the operators of the filter.
+		query := transaction.Statement.SQL.String()
+		require.True(t, strings.HasSuffix(query, modelCondition), query)
+
+		_, where, _ := strings.Cut(strings.TrimSuffix(strings.TrimSuffix(query, modelCondition), " AND "), " WHERE ")
+		assert.True(t, !strings.Contains(filter, " OR ") || isGroup(where), query)
+		assert.Equal(t, []any{TraceMetadataModelID, "m-1"}, transaction.Statement.Vars[len(transaction.Statement.Vars)-2:])
+	}
+}
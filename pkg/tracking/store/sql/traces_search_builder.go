@@ -0,0 +1,215 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+const (
+	TraceTagName     = "mlflow.traceName"
+	TraceMetadataRun = "mlflow.sourceRun"
+	// TraceMetadataModelID is the request metadata key linking a trace to a logged model.
+	TraceMetadataModelID = "mlflow.modelId"
+	requestMetadata      = "request_metadata"
+	traceTimestampKey    = "timestamp_ms"
+)
+
+// tracesFilterTranslator translates the comparisons of a traces filter. The subqueries
+// select the matching traces in request_id.
+type tracesFilterTranslator struct{}
+
+func (tracesFilterTranslator) TranslateFilter(
+	database *gorm.DB, clause *parser.ValidCompareExpr,
+) (*sql.Filter, *contract.Error) {
+	var kind any
+
+	key := clause.Key
+
+	switch clause.Identifier {
+	case parser.Tag:
+		kind = &models.TraceTag{}
+	case parser.RequestMetadata:
+		kind = &models.TraceRequestMetadata{}
+	default:
+		kind = nil
+	}
+
+	// "name" and "run_id" are not columns of trace_info,
+	// they are stored as a tag and a request metadata entry respectively.
+	switch {
+	case kind == nil && key == parser.TraceName:
+		kind = &models.TraceTag{}
+		key = TraceTagName
+	case kind == nil && key == parser.TraceRunID:
+		kind = &models.TraceRequestMetadata{}
+		key = TraceMetadataRun
+	}
+
+	if kind == nil {
+		where, value := sql.ComparisonCondition(database, "trace_info."+key, clause)
+
+		return &sql.Filter{Where: where, Value: value}, nil
+	}
+
+	return &sql.Filter{
+		Subquery: database.Select("request_id", "value").Where("key = ?", key).Where(
+			sql.ComparisonCondition(database, "value", clause),
+		).Model(kind),
+	}, nil
+}
+
+var tracesFilterEntity = &sql.FilterEntity{
+	Table:      "trace_info",
+	Keys:       []string{"request_id"},
+	Schema:     parser.TraceSchema,
+	Translator: tracesFilterTranslator{},
+}
+
+func applyTracesFilter(ctx context.Context, database, transaction *gorm.DB, filter string) *contract.Error {
+	return sql.ApplyFilter(ctx, database, transaction, tracesFilterEntity, filter)
+}
+
+// applyTracesModelFilter keeps the traces of the logged model modelID, when it is set. It is a
+// condition of its own rather than part of the filter, for the filter not to bind to it.
+func applyTracesModelFilter(database, transaction *gorm.DB, modelID string) {
+	if modelID == "" {
+		return
+	}
+
+	transaction.Where(
+		"trace_info.request_id IN (?)",
+		database.Model(&models.TraceRequestMetadata{}).Select("request_id").Where(
+			"key = ? AND value = ?", TraceMetadataModelID, modelID,
+		),
+	)
+}
+
+func traceOrderByKeyAlias(key string) string {
+	switch key {
+	case "timestamp":
+		return traceTimestampKey
+	case "execution_time":
+		return "execution_time_ms"
+	default:
+		return key
+	}
+}
+
+func translateTraceIdentifierAlias(identifier *string) string {
+	if identifier == nil {
+		return attribute
+	}
+
+	switch *identifier {
+	case "trace":
+		return attribute
+	case "metadata", requestMetadata:
+		return requestMetadata
+	default:
+		return *identifier
+	}
+}
+
+//nolint:funlen,cyclop
+func applyTracesOrderBy(ctx context.Context, database, transaction *gorm.DB, orderBy []string) *contract.Error {
+	timestampOrder := false
+	columnSelection := "trace_info.*"
+
+	for index, orderByClause := range orderBy {
+		orderByExpr, err := processOrderByClause(orderByClause)
+		if err != nil {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"invalid order_by clause %q.",
+					orderByClause,
+				),
+			)
+		}
+
+		utils.GetLoggerFromContext(ctx).Debugf(
+			"OrderByExpr: identifier: %v, key: %v, order: %v",
+			utils.DumpStringPointer(orderByExpr.identifier),
+			orderByExpr.key,
+			utils.DumpStringPointer(orderByExpr.order),
+		)
+
+		identifier := translateTraceIdentifierAlias(orderByExpr.identifier)
+		key := traceOrderByKeyAlias(orderByExpr.key)
+
+		var kind any
+
+		switch identifier {
+		case attribute:
+			switch key {
+			case traceTimestampKey:
+				timestampOrder = true
+			case parser.TraceName:
+				kind = &models.TraceTag{}
+				key = TraceTagName
+			case "experiment_id", "execution_time_ms", "status", "request_id":
+			default:
+				return contract.NewError(
+					protos.ErrorCode_INVALID_PARAMETER_VALUE,
+					fmt.Sprintf("Invalid order_by entity: %s", orderByClause),
+				)
+			}
+		case "tag":
+			kind = &models.TraceTag{}
+		case requestMetadata:
+			kind = &models.TraceRequestMetadata{}
+		default:
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid order_by entity: %s", orderByClause),
+			)
+		}
+
+		table := fmt.Sprintf("order_%d", index)
+		column := "trace_info." + key
+
+		if kind != nil {
+			transaction.Joins(
+				fmt.Sprintf("LEFT OUTER JOIN (?) AS %s ON trace_info.request_id = %s.request_id", table, table),
+				database.Select("request_id", "value").Where("key = ?", key).Model(kind),
+			)
+
+			column = table + ".value"
+		}
+
+		nullableColumnAlias := fmt.Sprintf("order_null_%d", index)
+		columnSelection = fmt.Sprintf(
+			"%s, (CASE WHEN (%s IS NULL) THEN 1 ELSE 0 END) AS %s",
+			columnSelection,
+			column,
+			nullableColumnAlias,
+		)
+
+		transaction.Order(nullableColumnAlias)
+		transaction.Order(clause.OrderByColumn{
+			Column: clause.Column{
+				Name: column,
+			},
+			Desc: orderByExpr.order != nil && *orderByExpr.order == "DESC",
+		})
+	}
+
+	if !timestampOrder {
+		transaction.Order("trace_info.timestamp_ms DESC")
+	}
+
+	transaction.Order("trace_info.request_id")
+	transaction.Select(columnSelection)
+
+	return nil
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/mlflow/mlflow-go-backend/pkg/contract"
 	"github.com/mlflow/mlflow-go-backend/pkg/entities"
@@ -16,11 +17,17 @@ type TrackingStore interface {
 	MetricTrackingStore
 	ExperimentTrackingStore
 	InputTrackingStore
+	LoggedModelTrackingStore
+	SearchExplainer
+	IdempotencyKeyStore
+	RunHeartbeatStore
 }
 
 type (
 	RunTrackingStore interface {
 		GetRun(ctx context.Context, runID string) (*entities.Run, *contract.Error)
+		// GetRunArtifactURI returns the root artifact URI of the run without loading the rest of it.
+		GetRunArtifactURI(ctx context.Context, runID string) (string, *contract.Error)
 		CreateRun(
 			ctx context.Context,
 			experimentID string,
@@ -76,6 +83,25 @@ type (
 			maxTraces int32,
 			requestIDs []string,
 		) (int32, *contract.Error)
+		// SearchTraces returns the traces matching filter, restricted to those of the logged model
+		// modelID when it is set.
+		SearchTraces(
+			ctx context.Context,
+			experimentIDs []string,
+			filter string,
+			modelID string,
+			maxResults int,
+			orderBy []string,
+			pageToken string,
+		) ([]*entities.TraceInfo, string, *contract.Error)
+		SearchTracesV3(
+			ctx context.Context,
+			experimentIDs []string,
+			filter string,
+			maxResults int,
+			orderBy []string,
+			pageToken string,
+		) ([]*entities.TraceInfoV3, string, *contract.Error)
 	}
 	MetricTrackingStore interface {
 		LogBatch(
@@ -90,6 +116,11 @@ type (
 		GetMetricHistory(
 			ctx context.Context, runID, metricKey, pageToken string, maxResults *int32,
 		) ([]*entities.Metric, string, *contract.Error)
+		// GetMetricHistoryBulkInterval returns the history of metricKey for the given runs,
+		// sampled down to at most maxResults steps within [startStep, endStep].
+		GetMetricHistoryBulkInterval(
+			ctx context.Context, runIDs []string, metricKey string, startStep, endStep *int64, maxResults int,
+		) ([]*entities.MetricWithRunID, *contract.Error)
 	}
 
 	ExperimentTrackingStore interface {
@@ -134,4 +165,81 @@ type (
 			ctx context.Context, runID string, modelInputs []*entities.ModelInput, datasets []*entities.DatasetInput,
 		) *contract.Error
 	}
+	LoggedModelTrackingStore interface {
+		CreateLoggedModel(
+			ctx context.Context,
+			experimentID string,
+			name string,
+			modelType *string,
+			sourceRunID *string,
+			params []*entities.LoggedModelParam,
+			tags []*entities.LoggedModelTag,
+		) (*entities.LoggedModel, *contract.Error)
+		// GetLoggedModel returns the logged model with its tags, params and metrics.
+		// Deleted models are reported as RESOURCE_DOES_NOT_EXIST.
+		GetLoggedModel(ctx context.Context, modelID string) (*entities.LoggedModel, *contract.Error)
+		FinalizeLoggedModel(ctx context.Context, modelID, status string) (*entities.LoggedModel, *contract.Error)
+		DeleteLoggedModel(ctx context.Context, modelID string) *contract.Error
+		SetLoggedModelTags(ctx context.Context, modelID string, tags []*entities.LoggedModelTag) *contract.Error
+		DeleteLoggedModelTag(ctx context.Context, modelID, key string) *contract.Error
+		LogLoggedModelParams(ctx context.Context, modelID string, params []*entities.LoggedModelParam) *contract.Error
+		SearchLoggedModels(
+			ctx context.Context,
+			experimentIDs []string,
+			filter string,
+			datasets []*entities.LoggedModelDataset,
+			maxResults int,
+			orderBy []*entities.LoggedModelOrderBy,
+			pageToken string,
+		) ([]*entities.LoggedModel, string, *contract.Error)
+	}
+	// SearchExplainer returns the SQL query of the searches, and the plan of the database for
+	// it, instead of running them.
+	SearchExplainer interface {
+		ExplainSearchRuns(
+			ctx context.Context,
+			experimentIDs []string,
+			filter string,
+			runViewType protos.ViewType,
+			maxResults int,
+			orderBy []string,
+			pageToken string,
+		) (*entities.SearchExplanation, *contract.Error)
+		ExplainSearchExperiments(
+			ctx context.Context,
+			experimentViewType protos.ViewType,
+			maxResults int64,
+			filter string,
+			orderBy []string,
+			pageToken string,
+		) (*entities.SearchExplanation, *contract.Error)
+		ExplainSearchTraces(
+			ctx context.Context,
+			experimentIDs []string,
+			filter string,
+			maxResults int,
+			orderBy []string,
+			pageToken string,
+		) (*entities.SearchExplanation, *contract.Error)
+	}
+	// IdempotencyKeyStore records the responses to the requests made with an idempotency key.
+	IdempotencyKeyStore interface {
+		// ClaimIdempotencyKey records that the request of requestHash is processed with key, unless
+		// it was already claimed less than ttl ago, in which case it returns the recorded response.
+		ClaimIdempotencyKey(
+			ctx context.Context, key, requestHash string, ttl time.Duration,
+		) (*entities.IdempotentResponse, *contract.Error)
+		SaveIdempotentResponse(ctx context.Context, key string, status int, body []byte) *contract.Error
+		// ReleaseIdempotencyKey forgets the claim of key, for the request to be processed again.
+		ReleaseIdempotencyKey(ctx context.Context, key string) *contract.Error
+		// DeleteExpiredIdempotencyKeys deletes the keys claimed more than ttl ago, returning how many.
+		DeleteExpiredIdempotencyKeys(ctx context.Context, ttl time.Duration) (int64, *contract.Error)
+	}
+	// RunHeartbeatStore records the heartbeats of the runs, and fails the runs which stopped sending them.
+	RunHeartbeatStore interface {
+		RecordRunHeartbeat(ctx context.Context, runID string) *contract.Error
+		// ReapStaleRuns fails the running runs whose last heartbeat is older than the heartbeat timeout
+		// of their experiment, defaultTimeout unless it sets one, and returns their IDs.
+		ReapStaleRuns(ctx context.Context, defaultTimeout time.Duration) ([]string, *contract.Error)
+	}
 )
@@ -0,0 +1,17 @@
+package utils
+
+import "context"
+
+type replicaReadsKey struct{}
+
+// NewContextWithReplicaReads marks the reads of ctx as tolerating the replication lag of a read
+// replica, which reads that must see the writes just made must not.
+func NewContextWithReplicaReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaReadsKey{}, true)
+}
+
+func ReplicaReadsAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(replicaReadsKey{}).(bool)
+
+	return allowed
+}
@@ -3,4 +3,9 @@ package utils
 const (
 	TagRunName = "mlflow.runName"
 	TagUser    = "mlflow.user"
+	// TagRunHeartbeatTimeout is the experiment tag overriding the heartbeat timeout of its runs,
+	// as a duration like "30m".
+	TagRunHeartbeatTimeout = "mlflow.runHeartbeatTimeout"
+	// TagStaleRunReason is the run tag explaining why the reaper failed the run.
+	TagStaleRunReason = "mlflow.staleRunReason"
 )
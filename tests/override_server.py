@@ -72,6 +72,7 @@ def _init_server(
                 **(extra_env or {}),
             }.items()
         ],
+        serve_artifacts=True,
         shutdown_timeout="5s",
         tracking_store_uri=backend_uri,
     ):